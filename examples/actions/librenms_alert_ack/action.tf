@@ -0,0 +1,13 @@
+data "librenms_alerts" "core_switch" {
+  device_id = librenms_device.core_switch.id
+  state     = "alert"
+}
+
+# Acknowledge the first open alert of a device until it clears.
+action "librenms_alert_ack" "core_switch" {
+  config {
+    alert_id    = data.librenms_alerts.core_switch.alerts[0].id
+    note        = "Core switch migration in progress"
+    until_clear = true
+  }
+}
@@ -0,0 +1,11 @@
+data "librenms_alerts" "acknowledged" {
+  device_id = librenms_device.core_switch.id
+  state     = "acknowledged"
+}
+
+# Unmute the first acknowledged alert of a device after the migration.
+action "librenms_alert_unmute" "core_switch" {
+  config {
+    alert_id = data.librenms_alerts.acknowledged.alerts[0].id
+  }
+}
@@ -0,0 +1,22 @@
+# Queue a device for rediscovery.
+action "librenms_device_discover" "core_switch" {
+  config {
+    device = "core-switch.mydomain.com"
+  }
+}
+
+# Rediscover a device after its SNMP settings change.
+resource "librenms_device" "core_switch" {
+  hostname = "core-switch.mydomain.com"
+
+  snmp_v2c = {
+    community = "public"
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.librenms_device_discover.core_switch]
+    }
+  }
+}
@@ -0,0 +1,33 @@
+# Put a device into maintenance for two hours.
+action "librenms_device_maintenance" "core_switch" {
+  config {
+    device   = "core-switch.mydomain.com"
+    duration = "2h"
+    title    = "Firmware upgrade"
+    notes    = "Scheduled by Terraform"
+    behavior = "mute_alerts"
+  }
+}
+
+# Put a device group into maintenance before the devices are replaced.
+action "librenms_device_maintenance" "edge_routers" {
+  config {
+    device_group = "Edge Routers"
+    duration     = "30m"
+  }
+}
+
+resource "librenms_device" "edge_router" {
+  hostname = "edge-router.mydomain.com"
+
+  snmp_v2c = {
+    community = "public"
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.librenms_device_maintenance.edge_routers]
+    }
+  }
+}
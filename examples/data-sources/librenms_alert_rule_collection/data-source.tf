@@ -0,0 +1,10 @@
+data "librenms_alert_rule_collection" "builtin" {}
+
+# create an alert rule from the LibreNMS alert rule collection
+resource "librenms_alertrule" "port_utilisation" {
+  name     = "Port utilisation over threshold"
+  disabled = false
+  severity = data.librenms_alert_rule_collection.builtin.rules["Port utilisation over threshold"].severity
+  delay    = data.librenms_alert_rule_collection.builtin.rules["Port utilisation over threshold"].delay
+  builder  = data.librenms_alert_rule_collection.builtin.rules["Port utilisation over threshold"].builder
+}
@@ -0,0 +1,8 @@
+# look up the built-in default template by name
+data "librenms_alert_templates" "default" {
+  name = "Default Alert Template"
+}
+
+output "default_template_id" {
+  value = data.librenms_alert_templates.default.templates[0].id
+}
@@ -0,0 +1,13 @@
+# List all active alerts.
+data "librenms_alerts" "active" {}
+
+# List unacknowledged critical alerts of a device.
+data "librenms_alerts" "core_switch" {
+  device_id = librenms_device.core_switch.id
+  state     = "alert"
+  severity  = "critical"
+}
+
+output "core_switch_alerts" {
+  value = [for alert in data.librenms_alerts.core_switch.alerts : "${alert.timestamp} ${alert.rule_name}"]
+}
@@ -0,0 +1,20 @@
+# preview the devices matched by dynamic device group rules
+data "librenms_devicegroup_preview" "cloud" {
+  rules = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.sysDescr",
+        "field" : "devices.sysDescr",
+        "operator" : "contains",
+        "value" : "cloud"
+      }
+    ],
+    "joins" : [],
+    "valid" : true
+  })
+}
+
+output "cloud_hostnames" {
+  value = data.librenms_devicegroup_preview.cloud.hostnames
+}
@@ -0,0 +1,13 @@
+# Look up a poller group managed outside of Terraform.
+data "librenms_poller_group" "secondary_dc" {
+  name = "secondary-dc"
+}
+
+resource "librenms_device" "remote_switch" {
+  hostname     = "remote-switch.mydomain.com"
+  poller_group = data.librenms_poller_group.secondary_dc.id
+
+  snmp_v2c = {
+    community = "public"
+  }
+}
@@ -0,0 +1,3 @@
+# Alert templates can be imported by specifying their numeric identifier or their name.
+terraform import librenms_alert_template.example 123
+terraform import librenms_alert_template.example device-down
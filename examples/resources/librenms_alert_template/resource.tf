@@ -0,0 +1,16 @@
+# a plain-text template attached to the device down rule
+resource "librenms_alert_template" "device_down" {
+  name      = "device-down"
+  title     = "{{ $alert->hostname }} is down"
+  title_rec = "{{ $alert->hostname }} has recovered"
+  rule_ids  = [librenms_alertrule.device_down.id]
+
+  template = <<-EOT
+    {{ $alert->title }}
+    Severity: {{ $alert->severity }}
+    @if ($alert->state == 0)
+    Time elapsed: {{ $alert->elapsed }}
+    @endif
+    Timestamp: {{ $alert->timestamp }}
+  EOT
+}
@@ -0,0 +1,3 @@
+# Alert transports can be imported by specifying their numeric identifier or their name.
+terraform import librenms_alert_transport.example 123
+terraform import librenms_alert_transport.example network-team-mail
@@ -0,0 +1,30 @@
+# send alerts to the network team mailbox
+resource "librenms_alert_transport" "network_mail" {
+  name = "network-team-mail"
+
+  mail = {
+    email = "network-team@example.com"
+  }
+}
+
+# post alerts to a Slack channel
+resource "librenms_alert_transport" "network_slack" {
+  name = "network-team-slack"
+
+  slack = {
+    url     = var.slack_webhook_url
+    channel = "#network-alerts"
+  }
+}
+
+# send alerts to a generic webhook
+resource "librenms_alert_transport" "webhook" {
+  name = "incident-webhook"
+
+  api = {
+    method  = "post"
+    url     = "https://incidents.example.com/hooks/librenms"
+    headers = "Content-Type=application/json"
+    body    = "{\"title\": \"{{ $title }}\", \"severity\": \"{{ $severity }}\"}"
+  }
+}
@@ -0,0 +1,3 @@
+# Alert transport groups can be imported by specifying their numeric identifier or their name.
+terraform import librenms_alert_transport_group.example 123
+terraform import librenms_alert_transport_group.example network-team
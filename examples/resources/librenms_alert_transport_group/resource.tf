@@ -0,0 +1,9 @@
+# route alerts to both the network team mailbox and Slack channel
+resource "librenms_alert_transport_group" "network_team" {
+  name = "network-team"
+
+  transport_ids = [
+    librenms_alert_transport.network_mail.id,
+    librenms_alert_transport.network_slack.id,
+  ]
+}
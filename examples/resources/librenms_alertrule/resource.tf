@@ -35,4 +35,7 @@ resource "librenms_alertrule" "rule1" {
 
   # defaults to all devices if devices is not defined
   # devices = [1, 2]
+
+  # defaults to the default transports if no transports are defined
+  # transport_ids = [librenms_alert_transport.network_mail.id]
 }
@@ -18,3 +18,27 @@ resource "librenms_device" "icmp_only_device" {
     os       = "Linux"
   }
 }
+
+# Manage device notes, attributes and module overrides.
+resource "librenms_device" "core_switch" {
+  hostname = "core-switch.mydomain.com"
+  notes    = "Rack A1, managed by the network team"
+
+  attributes = {
+    override_Oxidized_disable = "true"
+  }
+
+  # keep poll cycles short by disabling heavy modules
+  poller_modules = {
+    "bgp-peers"            = false
+    "cisco-mac-accounting" = false
+  }
+
+  discovery_modules = {
+    "bgp-peers" = false
+  }
+
+  snmp_v2c = {
+    community = "public"
+  }
+}
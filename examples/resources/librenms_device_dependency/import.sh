@@ -0,0 +1,2 @@
+# Device dependencies can be imported by specifying the numeric identifier of the child device.
+terraform import librenms_device_dependency.example 123
@@ -0,0 +1,9 @@
+# Suppress alerts for a server while its upstream switches are down.
+resource "librenms_device_dependency" "server" {
+  device_id = librenms_device.server.id
+
+  parent_ids = [
+    librenms_device.switch_a.id,
+    librenms_device.switch_b.id,
+  ]
+}
@@ -29,3 +29,15 @@ resource "librenms_devicegroup" "my_group" {
     librenms_device.device.id,
   ]
 }
+
+# create a static device group referencing devices
+# that are not managed by Terraform by hostname
+resource "librenms_devicegroup" "my_unmanaged_group" {
+  name = "my_unmanaged_group"
+  type = "static"
+
+  device_hostnames = [
+    "core-sw1.example.com",
+    "core-sw2.example.com",
+  ]
+}
@@ -0,0 +1,2 @@
+# Device group members can be imported by specifying the group ID and device ID, separated by a slash.
+terraform import librenms_devicegroup_member.example 12/123
@@ -0,0 +1,6 @@
+# add a device to a shared static device group without
+# managing the rest of the group's members
+resource "librenms_devicegroup_member" "pci_scope" {
+  group_id  = 12
+  device_id = librenms_device.payments_db.id
+}
@@ -0,0 +1,5 @@
+# Maintenance schedules can be imported by specifying their numeric identifier or their title.
+# Append a comma and the time zone to import the schedule in that time zone, otherwise UTC is used.
+terraform import librenms_maintenance_schedule.example 123
+terraform import librenms_maintenance_schedule.example "Core switch firmware upgrade"
+terraform import librenms_maintenance_schedule.example "Core switch firmware upgrade,Europe/Amsterdam"
@@ -0,0 +1,21 @@
+# a one-off maintenance window for a firmware upgrade
+resource "librenms_maintenance_schedule" "core_upgrade" {
+  title    = "Core switch firmware upgrade"
+  notes    = "CHG-1234"
+  start    = "2025-03-08 22:00"
+  end      = "2025-03-09 02:00"
+  timezone = "Europe/Amsterdam"
+
+  device_ids = [librenms_device.core1.id, librenms_device.core2.id]
+}
+
+# a weekly maintenance window for the lab, every weekend night in the first half of the year
+resource "librenms_maintenance_schedule" "lab_weekend" {
+  title          = "Lab weekend maintenance"
+  start          = "2025-01-01 01:00"
+  end            = "2025-06-30 05:00"
+  timezone       = "America/New_York"
+  recurring_days = ["saturday", "sunday"]
+
+  device_group_ids = [librenms_devicegroup.lab.id]
+}
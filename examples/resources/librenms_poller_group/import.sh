@@ -0,0 +1,3 @@
+# Poller groups can be imported by specifying their numeric identifier or their name.
+terraform import librenms_poller_group.example 2
+terraform import librenms_poller_group.example secondary-dc
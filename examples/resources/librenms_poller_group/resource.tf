@@ -0,0 +1,14 @@
+# Manage a poller group and assign a device to it.
+resource "librenms_poller_group" "secondary_dc" {
+  name        = "secondary-dc"
+  description = "Pollers in the secondary datacenter"
+}
+
+resource "librenms_device" "remote_switch" {
+  hostname     = "remote-switch.mydomain.com"
+  poller_group = librenms_poller_group.secondary_dc.id
+
+  snmp_v2c = {
+    community = "public"
+  }
+}
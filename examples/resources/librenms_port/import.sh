@@ -0,0 +1,3 @@
+# Ports can be imported by specifying their numeric identifier or the device hostname and the port ifName.
+terraform import librenms_port.example 1234
+terraform import librenms_port.example core-switch.mydomain.com/GigabitEthernet1/0/1
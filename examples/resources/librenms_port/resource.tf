@@ -0,0 +1,21 @@
+# Ports are discovered by LibreNMS. The resource adopts an existing port on create,
+# and restores its default settings on destroy instead of deleting it.
+resource "librenms_port" "server01" {
+  device_id   = librenms_device.core_switch.id
+  if_name     = "GigabitEthernet1/0/1"
+  description = "server01 eth0"
+  notes       = "Patch panel 3, port 12"
+}
+
+# Address a port by its ID, and override its polled speed.
+resource "librenms_port" "uplink" {
+  port_id = 1234
+  speed   = 10000000000
+}
+
+# Stop alerting on an unused port.
+resource "librenms_port" "spare" {
+  device_id = librenms_device.core_switch.id
+  if_name   = "GigabitEthernet1/0/48"
+  ignore    = true
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &alertAckAction{}
+	_ action.ActionWithConfigure = &alertAckAction{}
+)
+
+// NewAlertAckAction is a helper function to simplify the provider implementation.
+func NewAlertAckAction() action.Action {
+	return &alertAckAction{}
+}
+
+type (
+	// alertAckAction is the action implementation.
+	alertAckAction struct {
+		client *librenms.Client
+	}
+
+	// alertAckModel maps action schema data to a Go type.
+	alertAckModel struct {
+		AlertID    types.Int32  `tfsdk:"alert_id"`
+		Note       types.String `tfsdk:"note"`
+		UntilClear types.Bool   `tfsdk:"until_clear"`
+	}
+)
+
+// Metadata returns the action type name.
+func (a *alertAckAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_ack"
+}
+
+// Schema defines the schema for the action.
+func (a *alertAckAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Acknowledges an active LibreNMS alert.",
+		Attributes: map[string]schema.Attribute{
+			"alert_id": schema.Int32Attribute{
+				Description: "The ID of the alert to acknowledge.",
+				Required:    true,
+			},
+			"note": schema.StringAttribute{
+				Description: "The acknowledgement note.",
+				Optional:    true,
+			},
+			"until_clear": schema.BoolAttribute{
+				Description: "If true, the alert stays acknowledged until it clears, even if it gets worse." +
+					" Otherwise, the alert is raised again when it gets worse. Defaults to `false`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the action.
+func (a *alertAckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke acknowledges the alert.
+func (a *alertAckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config alertAckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertID := int(config.AlertID.ValueInt32())
+	_, err := a.client.AckAlert(alertID, alertAckPayload(&config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Acknowledging Alert",
+			fmt.Sprintf("Could not acknowledge alert %d: %s", alertID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Acknowledged alert %d", alertID),
+	})
+}
+
+// alertAckPayload builds the acknowledgement payload from the action configuration.
+func alertAckPayload(config *alertAckModel) *librenms.AlertAckRequest {
+	return &librenms.AlertAckRequest{
+		Note:       config.Note.ValueString(),
+		UntilClear: librenms.Bool(config.UntilClear.ValueBool()),
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestAccAlertAckAction_unknownAlert(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(testAccActionsMinVersion),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+action "librenms_alert_ack" "test" {
+  config {
+    alert_id    = 999999
+    note        = "acknowledged by terraform"
+    until_clear = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "ack"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.librenms_alert_ack.test]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Error Acknowledging Alert`),
+			},
+		},
+	})
+}
+
+func TestAlertAckPayload(t *testing.T) {
+	tests := map[string]struct {
+		config   alertAckModel
+		expected librenms.AlertAckRequest
+	}{
+		"defaults": {
+			config: alertAckModel{
+				AlertID:    types.Int32Value(1),
+				Note:       types.StringNull(),
+				UntilClear: types.BoolNull(),
+			},
+			expected: librenms.AlertAckRequest{Note: "", UntilClear: false},
+		},
+		"note": {
+			config: alertAckModel{
+				AlertID:    types.Int32Value(1),
+				Note:       types.StringValue("CHG-1234"),
+				UntilClear: types.BoolNull(),
+			},
+			expected: librenms.AlertAckRequest{Note: "CHG-1234", UntilClear: false},
+		},
+		"until clear": {
+			config: alertAckModel{
+				AlertID:    types.Int32Value(1),
+				Note:       types.StringValue("CHG-1234"),
+				UntilClear: types.BoolValue(true),
+			},
+			expected: librenms.AlertAckRequest{Note: "CHG-1234", UntilClear: true},
+		},
+		"not until clear": {
+			config: alertAckModel{
+				AlertID:    types.Int32Value(1),
+				Note:       types.StringNull(),
+				UntilClear: types.BoolValue(false),
+			},
+			expected: librenms.AlertAckRequest{Note: "", UntilClear: false},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := alertAckPayload(&tc.config)
+			if *payload != tc.expected {
+				t.Errorf("expected payload %+v, got %+v", tc.expected, *payload)
+			}
+		})
+	}
+}
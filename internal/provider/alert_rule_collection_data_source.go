@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// alertRuleCollectionVersion is the LibreNMS release the embedded alert rule collection is vendored from.
+// Update the tag in the go:generate directive below together with this version.
+const alertRuleCollectionVersion = "24.10.0"
+
+//go:generate go run ../tools/fetchalertrules -tag 24.10.0 -out data/alert_rules.json
+
+var (
+	//go:embed data/alert_rules.json
+	alertRulesJSON []byte
+
+	// alertRuleCollection is the snapshot of the LibreNMS alert rule collection, keyed by rule name.
+	alertRuleCollection = mustLoadAlertRuleCollection()
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &alertRuleCollectionDataSource{}
+)
+
+// NewAlertRuleCollectionDataSource is a helper function to simplify the provider implementation.
+func NewAlertRuleCollectionDataSource() datasource.DataSource {
+	return &alertRuleCollectionDataSource{}
+}
+
+type (
+	// alertRuleCollectionDataSource is the data source implementation.
+	alertRuleCollectionDataSource struct{}
+
+	// alertRuleCollectionModel maps data source schema data to a Go type.
+	alertRuleCollectionModel struct {
+		Rules map[string]alertRuleCollectionRuleModel `tfsdk:"rules"`
+	}
+
+	// alertRuleCollectionRuleModel maps a collection rule to a Go type.
+	alertRuleCollectionRuleModel struct {
+		Builder  types.String `tfsdk:"builder"`
+		Delay    types.String `tfsdk:"delay"`
+		Name     types.String `tfsdk:"name"`
+		Severity types.String `tfsdk:"severity"`
+	}
+
+	// collectionAlertRule is a rule in the embedded alert rule collection.
+	collectionAlertRule struct {
+		Name     string          `json:"name"`
+		Severity string          `json:"severity"`
+		Builder  json.RawMessage `json:"builder"`
+		Extra    json.RawMessage `json:"extra"`
+
+		// Delay is parsed from Extra when the collection is loaded.
+		Delay string `json:"-"`
+	}
+)
+
+// Metadata returns the data source type name.
+func (d *alertRuleCollectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule_collection"
+}
+
+// Schema defines the schema for the data source.
+func (d *alertRuleCollectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the predefined alert rules from the LibreNMS alert rule collection, as shipped with LibreNMS " +
+			alertRuleCollectionVersion + ". The collection is embedded in the provider, so no API requests are made.",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.MapNestedAttribute{
+				Description: "The collection rules, keyed by rule name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"builder": schema.StringAttribute{
+							Description: "The rule logic in serialized JSON format, as used by `librenms_alertrule.builder`.",
+							Computed:    true,
+						},
+						"delay": schema.StringAttribute{
+							Description: "The suggested delay before the rule is triggered, in a format like `5m`, if the rule has one.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The rule name.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The rule severity [`ok`, `warning`, `critical`].",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read sets the rules from the embedded collection.
+func (d *alertRuleCollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := alertRuleCollectionModel{
+		Rules: make(map[string]alertRuleCollectionRuleModel, len(alertRuleCollection)),
+	}
+
+	for name, rule := range alertRuleCollection {
+		model := alertRuleCollectionRuleModel{
+			Builder:  types.StringValue(string(rule.Builder)),
+			Delay:    types.StringNull(),
+			Name:     types.StringValue(rule.Name),
+			Severity: types.StringValue(rule.Severity),
+		}
+		if rule.Delay != "" {
+			model.Delay = types.StringValue(rule.Delay)
+		}
+		state.Rules[name] = model
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// mustLoadAlertRuleCollection parses the embedded alert rule collection, compacting each builder.
+func mustLoadAlertRuleCollection() map[string]collectionAlertRule {
+	collection, err := loadAlertRuleCollection(alertRulesJSON)
+	if err != nil {
+		panic(fmt.Sprintf("unable to parse embedded LibreNMS alert rule collection: %s", err))
+	}
+	return collection
+}
+
+// loadAlertRuleCollection parses an alert rule collection in the LibreNMS misc/alert_rules.json format.
+// Rules without a builder cannot be used by librenms_alertrule and are skipped. If more than one rule has the
+// same name, the first one is kept, matching the order shown in the LibreNMS web UI.
+func loadAlertRuleCollection(data []byte) (map[string]collectionAlertRule, error) {
+	var rules []collectionAlertRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	collection := make(map[string]collectionAlertRule, len(rules))
+	for _, rule := range rules {
+		if _, ok := collection[rule.Name]; ok || len(rule.Builder) == 0 {
+			continue
+		}
+
+		builder, err := collectionRuleBuilder(rule.Builder)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q has an invalid builder: %w", rule.Name, err)
+		}
+		rule.Builder = builder
+
+		rule.Delay, err = collectionRuleDelay(rule.Extra)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q has an invalid extra: %w", rule.Name, err)
+		}
+
+		collection[rule.Name] = rule
+	}
+	return collection, nil
+}
+
+// collectionRuleBuilder returns the compacted builder, which may be a JSON object or a JSON-encoded string.
+func collectionRuleBuilder(raw json.RawMessage) (json.RawMessage, error) {
+	raw, err := unquoteCollectionJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var builder bytes.Buffer
+	if err := json.Compact(&builder, raw); err != nil {
+		return nil, err
+	}
+	return builder.Bytes(), nil
+}
+
+// collectionRuleDelay returns the delay in the rule extra, which is stored in seconds, in a format like `5m`.
+// It returns an empty string if the rule has no delay.
+func collectionRuleDelay(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	raw, err := unquoteCollectionJSON(raw)
+	if err != nil {
+		return "", err
+	}
+
+	var extra struct {
+		Delay json.Number `json:"delay"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&extra); err != nil {
+		return "", err
+	}
+
+	if extra.Delay == "" {
+		return "", nil
+	}
+
+	seconds, err := extra.Delay.Int64()
+	if err != nil {
+		return "", fmt.Errorf("invalid delay %q: %w", extra.Delay, err)
+	}
+
+	switch {
+	case seconds <= 0:
+		return "", nil
+	case seconds%86400 == 0:
+		return fmt.Sprintf("%dd", seconds/86400), nil
+	case seconds%3600 == 0:
+		return fmt.Sprintf("%dh", seconds/3600), nil
+	default:
+		// LibreNMS delays are set in whole minutes
+		return fmt.Sprintf("%dm", (seconds+59)/60), nil
+	}
+}
+
+// unquoteCollectionJSON decodes a JSON-encoded string into the JSON it contains, and returns other values unchanged.
+func unquoteCollectionJSON(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 || raw[0] != '"' {
+		return raw, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(s), nil
+}
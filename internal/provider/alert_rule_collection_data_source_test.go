@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertRuleCollectionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "librenms_alert_rule_collection" "test" {}
+
+resource "librenms_alertrule" "test" {
+  name     = "Test Collection Rule"
+  disabled = true
+  severity = data.librenms_alert_rule_collection.test.rules["Devices up/down"].severity
+  builder  = data.librenms_alert_rule_collection.test.rules["Devices up/down"].builder
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.librenms_alert_rule_collection.test", "rules.Devices up/down.severity", "critical"),
+					resource.TestCheckNoResourceAttr("data.librenms_alert_rule_collection.test", "rules.Devices up/down.delay"),
+					resource.TestCheckResourceAttr("data.librenms_alert_rule_collection.test", "rules.Port utilisation over threshold.delay", "5m"),
+					resource.TestCheckResourceAttr("librenms_alertrule.test", "severity", "critical"),
+					resource.TestCheckResourceAttrSet("librenms_alertrule.test", "query"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlertRuleCollection(t *testing.T) {
+	if len(alertRuleCollection) == 0 {
+		t.Fatal("expected the embedded alert rule collection to contain rules")
+	}
+
+	for name, rule := range alertRuleCollection {
+		if name != rule.Name {
+			t.Errorf("rule %q is keyed as %q", rule.Name, name)
+		}
+
+		switch rule.Severity {
+		case "ok", "warning", "critical":
+		default:
+			t.Errorf("rule %q has unexpected severity %q", name, rule.Severity)
+		}
+
+		// every builder must survive the same comparison used by librenms_alertrule
+		builder := newQueryBuilderValue(string(rule.Builder))
+		equal, diags := builder.StringSemanticEquals(context.Background(), builder)
+		if diags.HasError() {
+			t.Errorf("rule %q has an invalid builder: %v", name, diags)
+		} else if !equal {
+			t.Errorf("rule %q builder is not equal to itself", name)
+		}
+	}
+}
+
+func TestLoadAlertRuleCollection(t *testing.T) {
+	collection, err := loadAlertRuleCollection([]byte(`[
+  {"name": "object", "severity": "critical", "builder": {"condition": "AND", "rules": [], "valid": true}},
+  {"name": "string", "severity": "warning", "builder": "{\"condition\":\"AND\",\"rules\":[],\"valid\":true}", "extra": "{\"delay\":300}"},
+  {"name": "hours", "severity": "warning", "builder": {"condition": "AND"}, "extra": {"delay": "7200"}},
+  {"name": "seconds", "severity": "warning", "builder": {"condition": "AND"}, "extra": {"delay": 90}},
+  {"name": "object", "severity": "ok", "builder": {"condition": "OR"}},
+  {"name": "legacy", "severity": "ok", "rule": "%macros.device_down = 1"}
+]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]struct {
+		severity string
+		builder  string
+		delay    string
+	}{
+		"object":  {severity: "critical", builder: `{"condition":"AND","rules":[],"valid":true}`},
+		"string":  {severity: "warning", builder: `{"condition":"AND","rules":[],"valid":true}`, delay: "5m"},
+		"hours":   {severity: "warning", builder: `{"condition":"AND"}`, delay: "2h"},
+		"seconds": {severity: "warning", builder: `{"condition":"AND"}`, delay: "2m"},
+	}
+
+	if len(collection) != len(expected) {
+		t.Fatalf("expected %d rules, got %d", len(expected), len(collection))
+	}
+
+	for name, want := range expected {
+		rule, ok := collection[name]
+		if !ok {
+			t.Errorf("rule %q is missing", name)
+			continue
+		}
+		if rule.Severity != want.severity {
+			t.Errorf("rule %q: expected severity %q, got %q", name, want.severity, rule.Severity)
+		}
+		if string(rule.Builder) != want.builder {
+			t.Errorf("rule %q: expected builder %s, got %s", name, want.builder, rule.Builder)
+		}
+		if rule.Delay != want.delay {
+			t.Errorf("rule %q: expected delay %q, got %q", name, want.delay, rule.Delay)
+		}
+	}
+
+	if _, err := loadAlertRuleCollection([]byte(`[{"name": "bad", "builder": {}, "extra": {"delay": "soon"}}]`)); err == nil {
+		t.Error("expected error for an invalid delay, got none")
+	}
+}
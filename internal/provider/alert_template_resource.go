@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertTemplateResource{}
+	_ resource.ResourceWithConfigure   = &alertTemplateResource{}
+	_ resource.ResourceWithImportState = &alertTemplateResource{}
+)
+
+// NewAlertTemplateResource is a helper function to simplify the provider implementation.
+func NewAlertTemplateResource() resource.Resource {
+	return &alertTemplateResource{}
+}
+
+type (
+	// alertTemplateResource is the resource implementation.
+	alertTemplateResource struct {
+		client *librenms.Client
+	}
+
+	// alertTemplateModel maps resource schema data to a Go type.
+	alertTemplateModel struct {
+		ID       types.Int32     `tfsdk:"id"`
+		Name     types.String    `tfsdk:"name"`
+		RuleIDs  types.Set       `tfsdk:"rule_ids"`
+		Template multilineString `tfsdk:"template"`
+		Title    types.String    `tfsdk:"title"`
+		TitleRec types.String    `tfsdk:"title_rec"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *alertTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_template"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the LibreNMS alert template.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The alert template name.",
+				Required:    true,
+			},
+			"rule_ids": schema.SetAttribute{
+				Description: "The set of alert rule IDs that use this template. Rules without a template use the default template.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"template": schema.StringAttribute{
+				Description: "The alert template body, in Laravel Blade syntax." +
+					" Differences in line endings, trailing whitespace, and leading or trailing blank lines are ignored.",
+				Required:   true,
+				CustomType: multilineStringType{},
+			},
+			"title": schema.StringAttribute{
+				Description: "The alert title template. If not set, the LibreNMS default title is used.",
+				Optional:    true,
+			},
+			"title_rec": schema.StringAttribute{
+				Description: "The recovery alert title template. If not set, the LibreNMS default recovery title is used.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *alertTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan alertTemplateModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := alertTemplatePayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templateResp, err := r.client.CreateAlertTemplate(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Template",
+			fmt.Sprintf("Could not create alert template: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int32Value(int32(templateResp.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state alertTemplateModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	templateResp, err := r.client.GetAlertTemplate(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Template",
+			fmt.Sprintf("Could not read LibreNMS alert template ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if templateResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Template",
+			"Received nil response when reading alert template. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	if len(templateResp.Templates) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Alert Template Get Response",
+			fmt.Sprintf("Expected one alert template to be retrieved, got %d alert templates. Please check the LibreNMS API.", len(templateResp.Templates)),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(setAlertTemplateState(ctx, &state, &templateResp.Templates[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan alertTemplateModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := alertTemplatePayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAlertTemplate(int(plan.ID.ValueInt32()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Alert Template",
+			fmt.Sprintf("Could not update alert template: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertTemplateModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing template
+	_, err := r.client.DeleteAlertTemplate(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Alert Template",
+			"Could not delete alert template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an alert template by numeric ID or by name.
+func (r *alertTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		templatesResp, err := r.client.GetAlertTemplates()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Templates",
+				fmt.Sprintf("Could not read alert templates to import %q: %s", req.ID, err.Error()),
+			)
+			return
+		}
+
+		if templatesResp == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Templates",
+				"Received nil response when reading alert templates. Please check the LibreNMS API.",
+			)
+			return
+		}
+
+		id = -1
+		for _, template := range templatesResp.Templates {
+			if template.Name != req.ID {
+				continue
+			}
+
+			if id >= 0 {
+				resp.Diagnostics.AddError(
+					"Ambiguous Alert Template Name",
+					fmt.Sprintf("More than one alert template is named %q. Import the template by its numeric ID instead.", req.ID),
+				)
+				return
+			}
+			id = int64(template.ID)
+		}
+
+		if id < 0 {
+			resp.Diagnostics.AddError(
+				"Alert Template Not Found",
+				fmt.Sprintf("Expected a numeric ID or the name of an existing alert template for import, but got %q.", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
+
+// alertTemplatePayload builds the create and update payload from the plan.
+func alertTemplatePayload(ctx context.Context, plan *alertTemplateModel) (*librenms.AlertTemplateCreateRequest, diag.Diagnostics) {
+	// unset titles are sent as empty strings, so that removing a title clears it in LibreNMS
+	title := plan.Title.ValueString()
+	titleRec := plan.TitleRec.ValueString()
+
+	payload := &librenms.AlertTemplateCreateRequest{
+		Name:     plan.Name.ValueString(),
+		Template: plan.Template.ValueString(),
+		Title:    &title,
+		TitleRec: &titleRec,
+		RuleIDs:  make([]int, 0),
+	}
+
+	diags := plan.RuleIDs.ElementsAs(ctx, &payload.RuleIDs, false)
+	return payload, diags
+}
+
+// setAlertTemplateState maps a LibreNMS alert template into the model.
+func setAlertTemplateState(ctx context.Context, state *alertTemplateModel, template *librenms.AlertTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.Int32Value(int32(template.ID))
+	state.Name = types.StringValue(template.Name)
+	state.Template = newMultilineStringValue(template.Template)
+
+	// LibreNMS stores unset titles as empty strings
+	state.Title = types.StringNull()
+	if template.Title != nil && *template.Title != "" {
+		state.Title = types.StringValue(*template.Title)
+	}
+
+	state.TitleRec = types.StringNull()
+	if template.TitleRec != nil && *template.TitleRec != "" {
+		state.TitleRec = types.StringValue(*template.TitleRec)
+	}
+
+	state.RuleIDs = types.SetNull(types.Int32Type)
+	if len(template.RuleIDs) > 0 {
+		state.RuleIDs, diags = types.SetValueFrom(ctx, types.Int32Type, template.RuleIDs)
+	}
+
+	return diags
+}
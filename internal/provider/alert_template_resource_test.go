@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertTemplateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_alert_template" "test" {
+  name     = "test template"
+  template = <<-EOT
+    {{ $alert->title }}
+    Severity: {{ $alert->severity }}
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_template.test", "name", "test template"),
+					resource.TestCheckResourceAttr("librenms_alert_template.test", "template", "{{ $alert->title }}\nSeverity: {{ $alert->severity }}\n"),
+					resource.TestCheckNoResourceAttr("librenms_alert_template.test", "title"),
+					resource.TestCheckNoResourceAttr("librenms_alert_template.test", "rule_ids"),
+					resource.TestCheckResourceAttrSet("librenms_alert_template.test", "id"),
+				),
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "librenms_alert_template.test",
+				ImportState:       true,
+				ImportStateId:     "test template",
+				ImportStateVerify: true,
+				// LibreNMS may strip the trailing newline of the template body
+				ImportStateVerifyIgnore: []string{"template"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_alertrule" "test_rule" {
+  name     = "test template rule"
+  disabled = true
+  severity = "warning"
+  builder = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.status",
+        "field" : "devices.status",
+        "type" : "integer",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "0"
+      }
+    ],
+    "valid" : true
+  })
+}
+
+resource "librenms_alert_template" "test" {
+  name      = "test template"
+  title     = "Alert for {{ $alert->hostname }}"
+  title_rec = "Recovered {{ $alert->hostname }}"
+  rule_ids  = [librenms_alertrule.test_rule.id]
+  template  = <<-EOT
+    {{ $alert->title }}
+    Severity: {{ $alert->severity }}
+    Timestamp: {{ $alert->timestamp }}
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_template.test", "title", "Alert for {{ $alert->hostname }}"),
+					resource.TestCheckResourceAttr("librenms_alert_template.test", "title_rec", "Recovered {{ $alert->hostname }}"),
+					resource.TestCheckResourceAttr("librenms_alert_template.test", "rule_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("librenms_alert_template.test", "rule_ids.0", "librenms_alertrule.test_rule", "id"),
+				),
+			},
+			// Removing the titles clears them in LibreNMS
+			{
+				Config: providerConfig + `
+resource "librenms_alert_template" "test" {
+  name     = "test template"
+  template = <<-EOT
+    {{ $alert->title }}
+    Severity: {{ $alert->severity }}
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("librenms_alert_template.test", "title"),
+					resource.TestCheckNoResourceAttr("librenms_alert_template.test", "title_rec"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &alertTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &alertTemplatesDataSource{}
+)
+
+// NewAlertTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewAlertTemplatesDataSource() datasource.DataSource {
+	return &alertTemplatesDataSource{}
+}
+
+type (
+	// alertTemplatesDataSource is the data source implementation.
+	alertTemplatesDataSource struct {
+		client *librenms.Client
+	}
+
+	// alertTemplatesModel maps data source schema data to a Go type.
+	alertTemplatesModel struct {
+		Name      types.String         `tfsdk:"name"`
+		Templates []alertTemplateModel `tfsdk:"templates"`
+	}
+)
+
+// Metadata returns the data source type name.
+func (d *alertTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *alertTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LibreNMS alert templates, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "If set, only the alert template with this exact name is returned.",
+				Optional:    true,
+			},
+			"templates": schema.ListNestedAttribute{
+				Description: "The matching alert templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Description: "The unique numeric identifier of the alert template.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The alert template name.",
+							Computed:    true,
+						},
+						"rule_ids": schema.SetAttribute{
+							Description: "The set of alert rule IDs that use this template.",
+							Computed:    true,
+							ElementType: types.Int32Type,
+						},
+						"template": schema.StringAttribute{
+							Description: "The alert template body.",
+							Computed:    true,
+							CustomType:  multilineStringType{},
+						},
+						"title": schema.StringAttribute{
+							Description: "The alert title template, if set.",
+							Computed:    true,
+						},
+						"title_rec": schema.StringAttribute{
+							Description: "The recovery alert title template, if set.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the data source.
+func (d *alertTemplatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *alertTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state alertTemplatesModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templatesResp, err := d.client.GetAlertTemplates()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Templates",
+			fmt.Sprintf("Could not read LibreNMS alert templates: %s", err.Error()),
+		)
+		return
+	}
+
+	if templatesResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Templates",
+			"Received nil response when reading alert templates. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	state.Templates = make([]alertTemplateModel, 0, len(templatesResp.Templates))
+	for i := range templatesResp.Templates {
+		template := &templatesResp.Templates[i]
+		if !state.Name.IsNull() && template.Name != state.Name.ValueString() {
+			continue
+		}
+
+		var model alertTemplateModel
+		resp.Diagnostics.Append(setAlertTemplateState(ctx, &model, template)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Templates = append(state.Templates, model)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
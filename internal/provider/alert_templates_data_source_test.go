@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertTemplatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_alert_template" "test" {
+  name     = "test template lookup"
+  template = "{{ $alert->title }}"
+}
+
+data "librenms_alert_templates" "all" {
+  depends_on = [librenms_alert_template.test]
+}
+
+data "librenms_alert_templates" "test" {
+  name = librenms_alert_template.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.librenms_alert_templates.all", "templates.#"),
+					resource.TestCheckResourceAttr("data.librenms_alert_templates.test", "templates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.librenms_alert_templates.test", "templates.0.id", "librenms_alert_template.test", "id"),
+					resource.TestCheckResourceAttr("data.librenms_alert_templates.test", "templates.0.template", "{{ $alert->title }}"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertTransportGroupResource{}
+	_ resource.ResourceWithConfigure   = &alertTransportGroupResource{}
+	_ resource.ResourceWithImportState = &alertTransportGroupResource{}
+)
+
+// NewAlertTransportGroupResource is a helper function to simplify the provider implementation.
+func NewAlertTransportGroupResource() resource.Resource {
+	return &alertTransportGroupResource{}
+}
+
+type (
+	// alertTransportGroupResource is the resource implementation.
+	alertTransportGroupResource struct {
+		client *librenms.Client
+	}
+
+	// alertTransportGroupModel maps resource schema data to a Go type.
+	alertTransportGroupModel struct {
+		ID           types.Int32  `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		TransportIDs types.Set    `tfsdk:"transport_ids"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *alertTransportGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_transport_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertTransportGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the LibreNMS alert transport group.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The alert transport group name.",
+				Required:    true,
+			},
+			"transport_ids": schema.SetAttribute{
+				Description: "The set of alert transport IDs in the group.",
+				Required:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *alertTransportGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertTransportGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan alertTransportGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &librenms.AlertTransportGroupCreateRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	diags = plan.TransportIDs.ElementsAs(ctx, &payload.Members, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupResp, err := r.client.CreateAlertTransportGroup(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Transport Group",
+			fmt.Sprintf("Could not create alert transport group: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int32Value(int32(groupResp.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertTransportGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state alertTransportGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	groupResp, err := r.client.GetAlertTransportGroup(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Transport Group",
+			fmt.Sprintf("Could not read LibreNMS alert transport group ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if groupResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Transport Group",
+			"Received nil response when reading alert transport group. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	if len(groupResp.Groups) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Alert Transport Group Get Response",
+			fmt.Sprintf("Expected one alert transport group to be retrieved, got %d alert transport groups. Please check the LibreNMS API.", len(groupResp.Groups)),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	group := groupResp.Groups[0]
+	state.ID = types.Int32Value(int32(group.ID))
+	state.Name = types.StringValue(group.Name)
+
+	state.TransportIDs, diags = types.SetValueFrom(ctx, types.Int32Type, group.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertTransportGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan alertTransportGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &librenms.AlertTransportGroupCreateRequest{
+		Name: plan.Name.ValueString(),
+	}
+
+	diags = plan.TransportIDs.ElementsAs(ctx, &payload.Members, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAlertTransportGroup(int(plan.ID.ValueInt32()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Alert Transport Group",
+			fmt.Sprintf("Could not update alert transport group: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertTransportGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertTransportGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing group
+	_, err := r.client.DeleteAlertTransportGroup(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Alert Transport Group",
+			"Could not delete alert transport group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an alert transport group by numeric ID or by name.
+func (r *alertTransportGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		groupsResp, err := r.client.GetAlertTransportGroups()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Transport Groups",
+				fmt.Sprintf("Could not read alert transport groups to import %q: %s", req.ID, err.Error()),
+			)
+			return
+		}
+
+		if groupsResp == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Transport Groups",
+				"Received nil response when reading alert transport groups. Please check the LibreNMS API.",
+			)
+			return
+		}
+
+		id = -1
+		for _, group := range groupsResp.Groups {
+			if group.Name != req.ID {
+				continue
+			}
+
+			if id >= 0 {
+				resp.Diagnostics.AddError(
+					"Ambiguous Alert Transport Group Name",
+					fmt.Sprintf("More than one alert transport group is named %q. Import the transport group by its numeric ID instead.", req.ID),
+				)
+				return
+			}
+			id = int64(group.ID)
+		}
+
+		if id < 0 {
+			resp.Diagnostics.AddError(
+				"Alert Transport Group Not Found",
+				fmt.Sprintf("Expected a numeric ID or the name of an existing alert transport group for import, but got %q.", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
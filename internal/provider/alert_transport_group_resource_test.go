@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const alertTransportGroupSetupConfig = `
+resource "librenms_alert_transport" "mail" {
+  name = "test group mail"
+
+  mail = {
+    email = "noc@example.com"
+  }
+}
+resource "librenms_alert_transport" "msteams" {
+  name = "test group msteams"
+
+  msteams = {
+    url = "https://example.webhook.office.com/webhookb2/test"
+  }
+}
+`
+
+func TestAccAlertTransportGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + alertTransportGroupSetupConfig + `
+resource "librenms_alert_transport_group" "test" {
+  name          = "test transport group"
+  transport_ids = [librenms_alert_transport.mail.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_transport_group.test", "name", "test transport group"),
+					resource.TestCheckResourceAttr("librenms_alert_transport_group.test", "transport_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("librenms_alert_transport_group.test", "id"),
+				),
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "librenms_alert_transport_group.test",
+				ImportState:       true,
+				ImportStateId:     "test transport group",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + alertTransportGroupSetupConfig + `
+resource "librenms_alert_transport_group" "test" {
+  name = "test transport group"
+  transport_ids = [
+    librenms_alert_transport.msteams.id,
+    librenms_alert_transport.mail.id,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_transport_group.test", "transport_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
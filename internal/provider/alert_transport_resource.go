@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertTransportResource{}
+	_ resource.ResourceWithConfigure   = &alertTransportResource{}
+	_ resource.ResourceWithImportState = &alertTransportResource{}
+)
+
+// alertTransportField maps a transport block attribute to its LibreNMS transport config key.
+type alertTransportField struct {
+	Attribute   string
+	Key         string
+	Description string
+	Required    bool
+	Sensitive   bool
+}
+
+// alertTransportTypes defines the supported transport types, keyed by the LibreNMS transport type
+// which is also the name of the transport block.
+var alertTransportTypes = map[string][]alertTransportField{
+	"api": {
+		{Attribute: "method", Key: "api-method", Description: "The HTTP method [`get`, `post`, `put`].", Required: true},
+		{Attribute: "url", Key: "api-url", Description: "The URL to send the alert to.", Required: true},
+		{Attribute: "options", Key: "api-options", Description: "Query options, one `key=value` per line."},
+		{Attribute: "headers", Key: "api-headers", Description: "Request headers, one `key=value` per line."},
+		{Attribute: "body", Key: "api-body", Description: "The request body, for `post` and `put` requests."},
+		{Attribute: "username", Key: "api-auth-username", Description: "The basic auth username."},
+		{Attribute: "password", Key: "api-auth-password", Description: "The basic auth password.", Sensitive: true},
+	},
+	"mail": {
+		{Attribute: "email", Key: "email", Description: "The email address to send alerts to.", Required: true},
+	},
+	"msteams": {
+		{Attribute: "url", Key: "msteam-url", Description: "The Microsoft Teams incoming webhook URL.", Required: true, Sensitive: true},
+	},
+	"opsgenie": {
+		{Attribute: "url", Key: "genie-url", Description: "The Opsgenie integration URL, including the API key.", Required: true, Sensitive: true},
+	},
+	"pagerduty": {
+		{Attribute: "service_key", Key: "service_key", Description: "The PagerDuty integration key.", Required: true, Sensitive: true},
+	},
+	"slack": {
+		{Attribute: "url", Key: "slack-url", Description: "The Slack incoming webhook URL.", Required: true, Sensitive: true},
+		{Attribute: "channel", Key: "slack-channel", Description: "The channel to post to, overriding the webhook default."},
+		{Attribute: "username", Key: "slack-author", Description: "The username to post as."},
+		{Attribute: "icon_emoji", Key: "slack-icon_emoji", Description: "The emoji to use as the icon, like `:warning:`."},
+	},
+	"telegram": {
+		{Attribute: "chat_id", Key: "telegram-chat-id", Description: "The Telegram chat ID.", Required: true},
+		{Attribute: "token", Key: "telegram-token", Description: "The Telegram bot token.", Required: true, Sensitive: true},
+		{Attribute: "format", Key: "telegram-format", Description: "The message format [`Markdown`, `HTML`]. Plain text is used if not set."},
+	},
+}
+
+// NewAlertTransportResource is a helper function to simplify the provider implementation.
+func NewAlertTransportResource() resource.Resource {
+	return &alertTransportResource{}
+}
+
+type (
+	// alertTransportResource is the resource implementation.
+	alertTransportResource struct {
+		client *librenms.Client
+	}
+
+	// alertTransportModel maps resource schema data to a Go type.
+	alertTransportModel struct {
+		ID        types.Int32  `tfsdk:"id"`
+		Name      types.String `tfsdk:"name"`
+		IsDefault types.Bool   `tfsdk:"is_default"`
+
+		API       types.Object `tfsdk:"api"`
+		Mail      types.Object `tfsdk:"mail"`
+		MSTeams   types.Object `tfsdk:"msteams"`
+		Opsgenie  types.Object `tfsdk:"opsgenie"`
+		PagerDuty types.Object `tfsdk:"pagerduty"`
+		Slack     types.Object `tfsdk:"slack"`
+		Telegram  types.Object `tfsdk:"telegram"`
+	}
+)
+
+// transportBlocks returns the transport blocks of the model, keyed by transport type.
+func (m *alertTransportModel) transportBlocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"api":       &m.API,
+		"mail":      &m.Mail,
+		"msteams":   &m.MSTeams,
+		"opsgenie":  &m.Opsgenie,
+		"pagerduty": &m.PagerDuty,
+		"slack":     &m.Slack,
+		"telegram":  &m.Telegram,
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *alertTransportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_transport"
+}
+
+// Schema defines the schema for the resource.
+func (r *alertTransportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int32Attribute{
+			Computed:    true,
+			Description: "The unique numeric identifier of the LibreNMS alert transport.",
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The alert transport name.",
+			Required:    true,
+		},
+		"is_default": schema.BoolAttribute{
+			Computed:    true,
+			Description: "If true, the transport is used by alert rules that have no transports attached. Defaults to `false`.",
+			Optional:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+
+	for transportType, fields := range alertTransportTypes {
+		blockAttributes := make(map[string]schema.Attribute, len(fields))
+		for _, field := range fields {
+			blockAttributes[field.Attribute] = schema.StringAttribute{
+				Description: field.Description,
+				Required:    field.Required,
+				Optional:    !field.Required,
+				Sensitive:   field.Sensitive,
+			}
+		}
+
+		attributes[transportType] = schema.SingleNestedAttribute{
+			Description: fmt.Sprintf("The `%s` transport configuration. Exactly one transport type must be configured.", transportType),
+			Optional:    true,
+			Attributes:  blockAttributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ConfigValidators defines validation rules for the resource configuration.
+func (r *alertTransportResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	expressions := make([]path.Expression, 0, len(alertTransportTypes))
+	for _, transportType := range sortedAlertTransportTypes() {
+		expressions = append(expressions, path.MatchRoot(transportType))
+	}
+
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(expressions...),
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *alertTransportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertTransportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan alertTransportModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := alertTransportPayload(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transportResp, err := r.client.CreateAlertTransport(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Transport",
+			fmt.Sprintf("Could not create alert transport: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int32Value(int32(transportResp.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertTransportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state alertTransportModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	transportResp, err := r.client.GetAlertTransport(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Transport",
+			fmt.Sprintf("Could not read LibreNMS alert transport ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if transportResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alert Transport",
+			"Received nil response when reading alert transport. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	if len(transportResp.Transports) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Alert Transport Get Response",
+			fmt.Sprintf("Expected one alert transport to be retrieved, got %d alert transports. Please check the LibreNMS API.", len(transportResp.Transports)),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	transport := transportResp.Transports[0]
+	state.ID = types.Int32Value(int32(transport.ID))
+	state.Name = types.StringValue(transport.Name)
+	state.IsDefault = types.BoolValue(bool(transport.IsDefault))
+
+	resp.Diagnostics.Append(setAlertTransportConfig(&state, transport.Type, transport.Config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertTransportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan alertTransportModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := alertTransportPayload(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAlertTransport(int(plan.ID.ValueInt32()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Alert Transport",
+			fmt.Sprintf("Could not update alert transport: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertTransportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertTransportModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing transport
+	_, err := r.client.DeleteAlertTransport(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Alert Transport",
+			"Could not delete alert transport, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an alert transport by numeric ID or by name.
+func (r *alertTransportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		transportsResp, err := r.client.GetAlertTransports()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Transports",
+				fmt.Sprintf("Could not read alert transports to import %q: %s", req.ID, err.Error()),
+			)
+			return
+		}
+
+		if transportsResp == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Alert Transports",
+				"Received nil response when reading alert transports. Please check the LibreNMS API.",
+			)
+			return
+		}
+
+		id = -1
+		for _, transport := range transportsResp.Transports {
+			if transport.Name != req.ID {
+				continue
+			}
+
+			if id >= 0 {
+				resp.Diagnostics.AddError(
+					"Ambiguous Alert Transport Name",
+					fmt.Sprintf("More than one alert transport is named %q. Import the transport by its numeric ID instead.", req.ID),
+				)
+				return
+			}
+			id = int64(transport.ID)
+		}
+
+		if id < 0 {
+			resp.Diagnostics.AddError(
+				"Alert Transport Not Found",
+				fmt.Sprintf("Expected a numeric ID or the name of an existing alert transport for import, but got %q.", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
+
+// alertTransportPayload builds the create and update payload from the configured transport block.
+func alertTransportPayload(plan *alertTransportModel) (*librenms.AlertTransportCreateRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := &librenms.AlertTransportCreateRequest{
+		Name:      plan.Name.ValueString(),
+		IsDefault: librenms.Bool(plan.IsDefault.ValueBool()),
+		Config:    make(map[string]string),
+	}
+
+	for transportType, block := range plan.transportBlocks() {
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+
+		payload.Type = transportType
+		attributes := block.Attributes()
+		for _, field := range alertTransportTypes[transportType] {
+			v, ok := attributes[field.Attribute].(types.String)
+			if !ok || v.IsNull() {
+				continue
+			}
+			payload.Config[field.Key] = v.ValueString()
+		}
+	}
+
+	if payload.Type == "" {
+		diags.AddError(
+			"Missing Alert Transport Configuration",
+			"Exactly one transport type block must be configured.",
+		)
+	}
+
+	return payload, diags
+}
+
+// setAlertTransportConfig maps the LibreNMS transport config to the block for the transport type,
+// and clears the other transport blocks.
+func setAlertTransportConfig(state *alertTransportModel, transportType string, config map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields, ok := alertTransportTypes[transportType]
+	if !ok {
+		diags.AddError(
+			"Unsupported Alert Transport Type",
+			fmt.Sprintf("The alert transport type %q is not supported by this provider. Supported types are %v.", transportType, sortedAlertTransportTypes()),
+		)
+		return diags
+	}
+
+	for blockType, block := range state.transportBlocks() {
+		*block = types.ObjectNull(alertTransportAttributeTypes(blockType))
+	}
+
+	attributes := make(map[string]attr.Value, len(fields))
+	for _, field := range fields {
+		if v, ok := config[field.Key]; ok && (v != "" || field.Required) {
+			attributes[field.Attribute] = types.StringValue(v)
+		} else {
+			attributes[field.Attribute] = types.StringNull()
+		}
+	}
+
+	var objectDiags diag.Diagnostics
+	*state.transportBlocks()[transportType], objectDiags = types.ObjectValue(alertTransportAttributeTypes(transportType), attributes)
+	diags.Append(objectDiags...)
+
+	return diags
+}
+
+// alertTransportAttributeTypes returns the attribute types of a transport block.
+func alertTransportAttributeTypes(transportType string) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type)
+	for _, field := range alertTransportTypes[transportType] {
+		attrTypes[field.Attribute] = types.StringType
+	}
+	return attrTypes
+}
+
+// sortedAlertTransportTypes returns the supported transport types in a stable order.
+func sortedAlertTransportTypes() []string {
+	transportTypes := make([]string, 0, len(alertTransportTypes))
+	for transportType := range alertTransportTypes {
+		transportTypes = append(transportTypes, transportType)
+	}
+	sort.Strings(transportTypes)
+	return transportTypes
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertTransportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_alert_transport" "test" {
+  name = "test transport"
+
+  mail = {
+    email = "noc@example.com"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_transport.test", "name", "test transport"),
+					resource.TestCheckResourceAttr("librenms_alert_transport.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("librenms_alert_transport.test", "mail.email", "noc@example.com"),
+					resource.TestCheckResourceAttrSet("librenms_alert_transport.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "librenms_alert_transport.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "librenms_alert_transport.test",
+				ImportState:       true,
+				ImportStateId:     "test transport",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, changing the transport type
+			{
+				Config: providerConfig + `
+resource "librenms_alert_transport" "test" {
+  name = "test transport"
+
+  api = {
+    method = "post"
+    url    = "https://example.com/hooks/librenms"
+    body   = "{\"title\": \"{{ $title }}\"}"
+
+    username = "librenms"
+    password = "secret"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alert_transport.test", "api.method", "post"),
+					resource.TestCheckResourceAttr("librenms_alert_transport.test", "api.password", "secret"),
+					resource.TestCheckNoResourceAttr("librenms_alert_transport.test", "mail.email"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAlertTransportConfigRoundTrip(t *testing.T) {
+	config := map[string]string{
+		"slack-url":     "https://hooks.slack.com/services/T000/B000/XXXX",
+		"slack-channel": "#alerts",
+		"slack-author":  "",
+	}
+
+	var model alertTransportModel
+	model.Name = types.StringValue("test")
+	model.IsDefault = types.BoolValue(true)
+
+	diags := setAlertTransportConfig(&model, "slack", config)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !model.Mail.IsNull() {
+		t.Error("expected the mail block to be null")
+	}
+	if !model.Slack.Attributes()["username"].IsNull() {
+		t.Error("expected the empty slack username to be null")
+	}
+
+	payload, diags := alertTransportPayload(&model)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if payload.Type != "slack" {
+		t.Errorf("expected transport type slack, got %q", payload.Type)
+	}
+
+	expected := map[string]string{
+		"slack-url":     "https://hooks.slack.com/services/T000/B000/XXXX",
+		"slack-channel": "#alerts",
+	}
+	if !reflect.DeepEqual(payload.Config, expected) {
+		t.Errorf("expected config %v, got %v", expected, payload.Config)
+	}
+
+	if diags := setAlertTransportConfig(&model, "discord", nil); !diags.HasError() {
+		t.Error("expected an error for an unsupported transport type")
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &alertUnmuteAction{}
+	_ action.ActionWithConfigure = &alertUnmuteAction{}
+)
+
+// NewAlertUnmuteAction is a helper function to simplify the provider implementation.
+func NewAlertUnmuteAction() action.Action {
+	return &alertUnmuteAction{}
+}
+
+type (
+	// alertUnmuteAction is the action implementation.
+	alertUnmuteAction struct {
+		client *librenms.Client
+	}
+
+	// alertUnmuteModel maps action schema data to a Go type.
+	alertUnmuteModel struct {
+		AlertID types.Int32 `tfsdk:"alert_id"`
+	}
+)
+
+// Metadata returns the action type name.
+func (a *alertUnmuteAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_unmute"
+}
+
+// Schema defines the schema for the action.
+func (a *alertUnmuteAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Unmutes an acknowledged LibreNMS alert, so that it is raised again.",
+		Attributes: map[string]schema.Attribute{
+			"alert_id": schema.Int32Attribute{
+				Description: "The ID of the alert to unmute.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the action.
+func (a *alertUnmuteAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke unmutes the alert.
+func (a *alertUnmuteAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config alertUnmuteModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertID := int(config.AlertID.ValueInt32())
+	_, err := a.client.UnmuteAlert(alertID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Unmuting Alert",
+			fmt.Sprintf("Could not unmute alert %d: %s", alertID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Unmuted alert %d", alertID),
+	})
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccAlertUnmuteAction_unknownAlert(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(testAccActionsMinVersion),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+action "librenms_alert_unmute" "test" {
+  config {
+    alert_id = 999999
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "unmute"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.librenms_alert_unmute.test]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Error Unmuting Alert`),
+			},
+		},
+	})
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -34,6 +39,7 @@ var (
 	_ resource.Resource                = &alertRuleResource{}
 	_ resource.ResourceWithConfigure   = &alertRuleResource{}
 	_ resource.ResourceWithImportState = &alertRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &alertRuleResource{}
 )
 
 // NewAlertRuleResource is a helper function to simplify the provider implementation.
@@ -49,22 +55,32 @@ type (
 
 	// alertRuleModel maps resource schema data to a Go type.
 	alertRuleModel struct {
-		ID           types.Int32          `tfsdk:"id"`
-		Builder      jsontypes.Normalized `tfsdk:"builder"`
-		Delay        types.String         `tfsdk:"delay"`
-		Devices      types.Set            `tfsdk:"devices"`
-		Disabled     types.Bool           `tfsdk:"disabled"`
-		Extra        jsontypes.Normalized `tfsdk:"extra"`
-		Groups       types.Set            `tfsdk:"groups"`
-		Interval     types.String         `tfsdk:"interval"`
-		Locations    types.Set            `tfsdk:"locations"`
-		MaxAlerts    types.Int32          `tfsdk:"max_alerts"` // `count` is a reserved root attribute
-		Mute         types.Bool           `tfsdk:"mute"`
-		Name         types.String         `tfsdk:"name"`
-		Notes        types.String         `tfsdk:"notes"`
-		ProcedureURL types.String         `tfsdk:"procedure_url"`
-		Query        types.String         `tfsdk:"query"`
-		Severity     types.String         `tfsdk:"severity"`
+		ID              types.Int32          `tfsdk:"id"`
+		Acknowledgement types.Bool           `tfsdk:"acknowledgement"`
+		Builder         queryBuilder         `tfsdk:"builder"`
+		Delay           types.String         `tfsdk:"delay"`
+		DeviceHostnames types.Set            `tfsdk:"device_hostnames"`
+		Devices         types.Set            `tfsdk:"devices"`
+		Disabled        types.Bool           `tfsdk:"disabled"`
+		Extra           jsontypes.Normalized `tfsdk:"extra"`
+		GroupNames      types.Set            `tfsdk:"group_names"`
+		Groups          types.Set            `tfsdk:"groups"`
+		Interval        types.String         `tfsdk:"interval"`
+		Invert          types.Bool           `tfsdk:"invert"`
+		LocationNames   types.Set            `tfsdk:"location_names"`
+		Locations       types.Set            `tfsdk:"locations"`
+		MaxAlerts       types.Int32          `tfsdk:"max_alerts"` // `count` is a reserved root attribute
+		Mute            types.Bool           `tfsdk:"mute"`
+		Name            types.String         `tfsdk:"name"`
+		Notes           types.String         `tfsdk:"notes"`
+		OverrideQuery   types.Bool           `tfsdk:"override_query"`
+		ProcedureURL    types.String         `tfsdk:"procedure_url"`
+		Query           types.String         `tfsdk:"query"`
+		Recovery        types.Bool           `tfsdk:"recovery"`
+		Severity        types.String         `tfsdk:"severity"`
+		TransportGroups types.Set            `tfsdk:"transport_group_ids"`
+		Transports      types.Set            `tfsdk:"transport_ids"`
+		UniqueName      types.Bool           `tfsdk:"unique_name"`
 	}
 )
 
@@ -84,15 +100,26 @@ func (r *alertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					int32planmodifier.UseStateForUnknown(),
 				},
 			},
+			"acknowledgement": schema.BoolAttribute{
+				Description: "Whether a notification is sent when the alert is acknowledged. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			"builder": schema.StringAttribute{
 				Description: "The alert rule builder field defines the rule logic in serialized JSON format.",
 				Required:    true,
-				CustomType:  jsontypes.NormalizedType{},
+				CustomType:  queryBuilderType{},
 			},
 			"delay": schema.StringAttribute{
 				Description: "The delay before the alert rule is triggered, in a format like `5m` or `1h`.",
 				Optional:    true,
 			},
+			"device_hostnames": schema.SetAttribute{
+				Description: "The set of device hostnames attached to the alert rule, resolved to device IDs at apply time. This can be used instead of `devices`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"devices": schema.SetAttribute{
 				Description: "The set of device IDs attached to the alert rule. If not set, the rule applies to all devices.",
 				Optional:    true,
@@ -107,6 +134,11 @@ func (r *alertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				CustomType:  jsontypes.NormalizedType{},
 			},
+			"group_names": schema.SetAttribute{
+				Description: "The set of device group names attached to the alert rule, resolved to group IDs at apply time. This can be used instead of `groups`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"groups": schema.SetAttribute{
 				Description: "The set of group IDs attached to the alert rule. This can be defined alongside `devices` and `locations`.",
 				Optional:    true,
@@ -116,6 +148,17 @@ func (r *alertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The interval at which the alert rule is checked, in a format like `5m` or `1h`.",
 				Optional:    true,
 			},
+			"invert": schema.BoolAttribute{
+				Description: "Whether the alert rule applies to all devices, groups, and locations except the ones selected (the LibreNMS `invert_map` setting). Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"location_names": schema.SetAttribute{
+				Description: "The set of location names attached to the alert rule, resolved to location IDs at apply time. This can be used instead of `locations`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"locations": schema.SetAttribute{
 				Description: "The set of location IDs attached to the alert rule. This can be defined alongside `devices` and `groups`.",
 				Optional:    true,
@@ -140,13 +183,28 @@ func (r *alertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The alert rule notes.",
 				Optional:    true,
 			},
+			"override_query": schema.BoolAttribute{
+				Description: "Whether the alert rule uses the custom SQL `query` instead of the query rendered from the builder rules." +
+					" Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"procedure_url": schema.StringAttribute{
 				Description: "A procedure URL (runbook) related to the alert.",
 				Optional:    true,
 			},
 			"query": schema.StringAttribute{
-				Description: "The SQL query rendered from the builder rules. This is set by LibreNMS.",
+				Description: "The SQL query of the alert rule. If `override_query` is `true`, this is the custom SQL query to run," +
+					" otherwise it is rendered from the builder rules by LibreNMS.",
+				Optional: true,
+				Computed: true,
+			},
+			"recovery": schema.BoolAttribute{
+				Description: "Whether a notification is sent when the alert recovers. Defaults to `true`.",
+				Optional:    true,
 				Computed:    true,
+				Default:     booldefault.StaticBool(true),
 			},
 			"severity": schema.StringAttribute{
 				Description: "The severity of the alert rule [`ok`, `warning`, `critical`].",
@@ -155,10 +213,50 @@ func (r *alertRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringvalidator.OneOf("ok", "warning", "critical"),
 				},
 			},
+			"transport_group_ids": schema.SetAttribute{
+				Description: "The set of alert transport group IDs the alert rule sends alerts to." +
+					" If neither `transport_ids` nor `transport_group_ids` is set, alerts are sent to the default transports.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"transport_ids": schema.SetAttribute{
+				Description: "The set of alert transport IDs the alert rule sends alerts to." +
+					" If neither `transport_ids` nor `transport_group_ids` is set, alerts are sent to the default transports.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"unique_name": schema.BoolAttribute{
+				Description: "Whether the plan fails if another alert rule already has the same `name`. Defaults to `false`.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+// ConfigValidators defines validation rules for the resource configuration.
+func (r *alertRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("devices"),
+			path.MatchRoot("device_hostnames"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("groups"),
+			path.MatchRoot("group_names"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("locations"),
+			path.MatchRoot("location_names"),
+		),
+	}
+}
+
 func (r *alertRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data alertRuleModel
 
@@ -178,6 +276,78 @@ func (r *alertRuleResource) ValidateConfig(ctx context.Context, req resource.Val
 		)
 		return
 	}
+
+	// a custom query is only used by LibreNMS when override_query is enabled
+	if data.OverrideQuery.IsUnknown() || data.Query.IsUnknown() {
+		return
+	}
+
+	if data.OverrideQuery.ValueBool() && data.Query.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query"),
+			"Missing Query Value",
+			"The query field must be set to a custom SQL query when override_query is true.",
+		)
+	}
+
+	if !data.OverrideQuery.ValueBool() && !data.Query.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query"),
+			"Unexpected Query Value",
+			"The query field can only be set when override_query is true, otherwise it is rendered from the builder rules by LibreNMS.",
+		)
+	}
+}
+
+// ModifyPlan rejects duplicate alert rule names at plan time when unique_name is set.
+func (r *alertRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan alertRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.UniqueName.ValueBool() || plan.Name.IsUnknown() || r.client == nil {
+		return
+	}
+
+	rulesResp, err := r.client.GetAlertRules()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Alert Rules",
+			fmt.Sprintf("Could not get alert rules to check that the name is unique: %s", err),
+		)
+		return
+	}
+
+	if rulesResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Alert Rules",
+			"Received nil response when getting alert rules. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	for _, rule := range rulesResp.Rules {
+		// the rule managed by this resource keeps its own name
+		if !plan.ID.IsUnknown() && !plan.ID.IsNull() && rule.ID == int(plan.ID.ValueInt32()) {
+			continue
+		}
+
+		if rule.Name == plan.Name.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate Alert Rule Name",
+				fmt.Sprintf("An alert rule named %q already exists with ID %d. Choose a different name, import the existing rule, or unset unique_name.", rule.Name, rule.ID),
+			)
+			return
+		}
+	}
 }
 
 // Configure sets the provider client for the resource.
@@ -210,16 +380,20 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Create the alert rule using the LibreNMS client.
 	payload := &librenms.AlertRuleCreateRequest{
-		Builder:      plan.Builder.ValueString(),
-		Count:        int(plan.MaxAlerts.ValueInt32()),
-		Delay:        plan.Delay.ValueString(),
-		Disabled:     librenms.Bool(plan.Disabled.ValueBool()),
-		Interval:     plan.Interval.ValueString(),
-		Mute:         plan.Mute.ValueBool(),
-		Name:         plan.Name.ValueString(),
-		Notes:        plan.Notes.ValueString(),
-		ProcedureURL: plan.ProcedureURL.ValueString(),
-		Severity:     plan.Severity.ValueString(),
+		Acknowledgement: plan.Acknowledgement.ValueBool(),
+		Builder:         plan.Builder.ValueString(),
+		Count:           int(plan.MaxAlerts.ValueInt32()),
+		Delay:           plan.Delay.ValueString(),
+		Disabled:        librenms.Bool(plan.Disabled.ValueBool()),
+		Interval:        plan.Interval.ValueString(),
+		InvertMap:       plan.Invert.ValueBool(),
+		Mute:            plan.Mute.ValueBool(),
+		Name:            plan.Name.ValueString(),
+		Notes:           plan.Notes.ValueString(),
+		OverrideQuery:   plan.OverrideQuery.ValueBool(),
+		ProcedureURL:    plan.ProcedureURL.ValueString(),
+		Recovery:        plan.Recovery.ValueBool(),
+		Severity:        plan.Severity.ValueString(),
 	}
 
 	diags = plan.Devices.ElementsAs(ctx, &payload.Devices, false)
@@ -240,37 +414,55 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	_, err := r.client.CreateAlertRule(payload)
+	resp.Diagnostics.Append(r.resolveTargetNames(ctx, &plan, payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.OverrideQuery.ValueBool() {
+		payload.AdvQuery = plan.Query.ValueString()
+	}
+
+	// remember the highest existing rule ID, so that existing rules with the same name are never matched below
+	priorRulesResp, err := r.client.GetAlertRules()
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Creating Alert Rule",
-			fmt.Sprintf("Could not create alert rule: %s", err),
+			"Error Getting Alert Rules",
+			fmt.Sprintf("Could not get alert rules before creation: %s", err),
 		)
 		return
 	}
 
-	// have to get all the alert rules, so we can match by name to get computed values
-	rulesResp, err := r.client.GetAlertRules()
-	if err != nil {
+	if priorRulesResp == nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Alert Rules",
-			fmt.Sprintf("Could not get alert rules after creation: %s", err),
+			"Received nil response when getting alert rules. Please check the LibreNMS API.",
 		)
 		return
 	}
 
-	// Find the created alert rule by name
-	var createdRule *librenms.AlertRule
-	for _, rule := range rulesResp.Rules {
-		if rule.Name == payload.Name {
-			createdRule = &rule
-			break
-		}
+	maxPriorID := 0
+	for _, rule := range priorRulesResp.Rules {
+		maxPriorID = max(maxPriorID, rule.ID)
 	}
-	if createdRule == nil {
+
+	createResp, err := r.client.CreateAlertRule(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Alert Rule",
+			fmt.Sprintf("Could not create alert rule: %s", err),
+		)
+		return
+	}
+
+	// devices read later in this run must see the new rule in their effective alert rules
+	clearCachedAlertRules(r.client)
+
+	createdRule, err := r.findCreatedAlertRule(ctx, createResp, payload, maxPriorID)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Finding Created Alert Rule",
-			fmt.Sprintf("Could not find the created alert rule with name %s in the response: %s", payload.Name, err),
+			fmt.Sprintf("The alert rule %q was created, but its ID could not be determined: %s", payload.Name, err),
 		)
 		return
 	}
@@ -280,6 +472,19 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 	plan.Extra = jsontypes.NewNormalizedValue(createdRule.Extra)
 	plan.Query = types.StringValue(createdRule.Query)
 
+	// remove the rule again if its transports cannot be applied, so it is not left behind outside of Terraform state
+	transportDiags := r.applyTransports(ctx, &plan)
+	resp.Diagnostics.Append(transportDiags...)
+	if transportDiags.HasError() {
+		if _, err := r.client.DeleteAlertRule(createdRule.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Alert Rule",
+				fmt.Sprintf("Could not remove alert rule ID %d after its transports failed to apply, it should be removed manually: %s", createdRule.ID, err),
+			)
+		}
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -327,9 +532,10 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Overwrite items with refreshed state
 	alertRule := alertResp.Rules[0]
 	state.ID = types.Int32Value(int32(alertRule.ID))
-	state.Builder = jsontypes.NewNormalizedValue(alertRule.Builder)
+	state.Builder = newQueryBuilderValue(alertRule.Builder)
 	state.Disabled = types.BoolValue(bool(alertRule.Disabled))
 	state.Extra = jsontypes.NewNormalizedValue(alertRule.Extra)
+	state.Invert = types.BoolValue(bool(alertRule.InvertMap))
 	state.Name = types.StringValue(alertRule.Name)
 	state.Query = types.StringValue(alertRule.Query)
 	state.Severity = types.StringValue(alertRule.Severity)
@@ -340,6 +546,17 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	//
 	// So, these fields will not be updated in Read(). This will cause apply changes after an initial import, but
 	// it shouldn't cause errors on apply.
+	//
+	// The advanced options are plain flags, so they are read back from extra when they're present.
+	extra, err := parseAlertRuleExtra(alertRule.Extra)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing Alert Rule Extra",
+			fmt.Sprintf("Could not parse extra of LibreNMS alert rule ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+	extra.apply(&state)
 
 	// check possibly null fields
 	if alertRule.Notes != nil {
@@ -354,40 +571,15 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.ProcedureURL = types.StringNull()
 	}
 
-	// Populate Devices list
-	if len(alertRule.Devices) > 0 {
-		var devicesDiags diag.Diagnostics
-		state.Devices, devicesDiags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Devices)
-		resp.Diagnostics.Append(devicesDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		state.Devices = types.SetNull(types.Int32Type)
-	}
-
-	// Populate Groups list
-	if len(alertRule.Groups) > 0 {
-		var groupsDiags diag.Diagnostics
-		state.Groups, groupsDiags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Groups)
-		resp.Diagnostics.Append(groupsDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		state.Groups = types.SetNull(types.Int32Type)
+	// Populate devices, groups, and locations, by name if that's how they're configured
+	resp.Diagnostics.Append(r.refreshTargets(ctx, &state, &alertRule)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Populate Locations list
-	if len(alertRule.Locations) > 0 {
-		var locationsDiags diag.Diagnostics
-		state.Locations, locationsDiags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Locations)
-		resp.Diagnostics.Append(locationsDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		state.Locations = types.SetNull(types.Int32Type)
+	resp.Diagnostics.Append(r.refreshTransports(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set refreshed state
@@ -412,16 +604,20 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	payload := &librenms.AlertRuleUpdateRequest{
 		ID: int(plan.ID.ValueInt32()),
 		AlertRuleCreateRequest: librenms.AlertRuleCreateRequest{
-			Builder:      plan.Builder.ValueString(),
-			Count:        int(plan.MaxAlerts.ValueInt32()),
-			Delay:        plan.Delay.ValueString(),
-			Disabled:     librenms.Bool(plan.Disabled.ValueBool()),
-			Interval:     plan.Interval.ValueString(),
-			Mute:         plan.Mute.ValueBool(),
-			Name:         plan.Name.ValueString(),
-			Notes:        plan.Notes.ValueString(),
-			ProcedureURL: plan.ProcedureURL.ValueString(),
-			Severity:     plan.Severity.ValueString(),
+			Acknowledgement: plan.Acknowledgement.ValueBool(),
+			Builder:         plan.Builder.ValueString(),
+			Count:           int(plan.MaxAlerts.ValueInt32()),
+			Delay:           plan.Delay.ValueString(),
+			Disabled:        librenms.Bool(plan.Disabled.ValueBool()),
+			Interval:        plan.Interval.ValueString(),
+			InvertMap:       plan.Invert.ValueBool(),
+			Mute:            plan.Mute.ValueBool(),
+			Name:            plan.Name.ValueString(),
+			Notes:           plan.Notes.ValueString(),
+			OverrideQuery:   plan.OverrideQuery.ValueBool(),
+			ProcedureURL:    plan.ProcedureURL.ValueString(),
+			Recovery:        plan.Recovery.ValueBool(),
+			Severity:        plan.Severity.ValueString(),
 		},
 	}
 
@@ -443,6 +639,15 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveTargetNames(ctx, &plan, &payload.AlertRuleCreateRequest)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.OverrideQuery.ValueBool() {
+		payload.AdvQuery = plan.Query.ValueString()
+	}
+
 	_, err := r.client.UpdateAlertRule(payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -451,6 +656,7 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		)
 		return
 	}
+	clearCachedAlertRules(r.client)
 
 	// have to get all the alert rules, so we can match by name to get computed values
 	ruleResp, err := r.client.GetAlertRule(payload.ID)
@@ -483,6 +689,11 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	plan.Extra = jsontypes.NewNormalizedValue(alertRule.Extra)
 	plan.Query = types.StringValue(alertRule.Query)
 
+	resp.Diagnostics.Append(r.applyTransports(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -508,6 +719,7 @@ func (r *alertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		)
 		return
 	}
+	clearCachedAlertRules(r.client)
 }
 
 func (r *alertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -522,3 +734,321 @@ func (r *alertRuleResource) ImportState(ctx context.Context, req resource.Import
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
 }
+
+// findCreatedAlertRule returns the alert rule created from the payload. The ID in the create response is preferred.
+// Older LibreNMS versions don't return it, in which case the rule is matched by name, severity, and builder.
+func (r *alertRuleResource) findCreatedAlertRule(ctx context.Context, createResp *librenms.AlertRuleCreateResponse, payload *librenms.AlertRuleCreateRequest, maxPriorID int) (*librenms.AlertRule, error) {
+	if createResp != nil && createResp.ID > 0 {
+		ruleResp, err := r.client.GetAlertRule(createResp.ID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get alert rule ID %d: %w", createResp.ID, err)
+		}
+
+		if ruleResp == nil || len(ruleResp.Rules) != 1 {
+			return nil, fmt.Errorf("expected one alert rule with ID %d, please check the LibreNMS API", createResp.ID)
+		}
+		return &ruleResp.Rules[0], nil
+	}
+
+	rulesResp, err := r.client.GetAlertRules()
+	if err != nil {
+		return nil, fmt.Errorf("could not get alert rules: %w", err)
+	}
+
+	if rulesResp == nil {
+		return nil, fmt.Errorf("received nil response when getting alert rules, please check the LibreNMS API")
+	}
+
+	return matchCreatedAlertRule(ctx, rulesResp.Rules, payload, maxPriorID)
+}
+
+// matchCreatedAlertRule picks the rule created from the payload: a rule with the same name, severity, and builder
+// whose ID is above maxPriorID. If more than one new rule matches, e.g. after a concurrent create, the match is ambiguous
+// and the candidates are listed, highest ID first.
+func matchCreatedAlertRule(ctx context.Context, rules []librenms.AlertRule, payload *librenms.AlertRuleCreateRequest, maxPriorID int) (*librenms.AlertRule, error) {
+	builder := newQueryBuilderValue(payload.Builder)
+
+	var matches, candidates []*librenms.AlertRule
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Name != payload.Name {
+			continue
+		}
+		matches = append(matches, rule)
+
+		if rule.ID <= maxPriorID || rule.Severity != payload.Severity {
+			continue
+		}
+
+		equal, diags := builder.StringSemanticEquals(ctx, newQueryBuilderValue(rule.Builder))
+		if diags.HasError() || !equal {
+			continue
+		}
+		candidates = append(candidates, rule)
+	}
+
+	switch len(candidates) {
+	case 0:
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no alert rule named %q was found", payload.Name)
+		}
+		return nil, fmt.Errorf("no new alert rule matched the name, severity, and builder; existing rules named %q: %s",
+			payload.Name, alertRuleIDList(matches))
+	case 1:
+		return candidates[0], nil
+	}
+
+	slices.SortFunc(candidates, func(a, b *librenms.AlertRule) int { return b.ID - a.ID })
+	return nil, fmt.Errorf("%d new alert rules match the name, severity, and builder: %s; import the correct rule and delete the others",
+		len(candidates), alertRuleIDList(candidates))
+}
+
+// alertRuleIDList formats the alert rule IDs for error messages.
+func alertRuleIDList(rules []*librenms.AlertRule) string {
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, fmt.Sprintf("ID %d", rule.ID))
+	}
+	return strings.Join(ids, ", ")
+}
+
+// resolveTargetNames resolves the configured device hostnames, group names, and location names to IDs in the payload.
+func (r *alertRuleResource) resolveTargetNames(ctx context.Context, plan *alertRuleModel, payload *librenms.AlertRuleCreateRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.DeviceHostnames.IsNull() {
+		var hostnames []string
+		diags.Append(plan.DeviceHostnames.ElementsAs(ctx, &hostnames, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		devices, err := resolveDeviceIDs(r.client, hostnames)
+		if err != nil {
+			diags.AddAttributeError(path.Root("device_hostnames"), "Error Resolving Device Hostnames", err.Error())
+			return diags
+		}
+		payload.Devices = devices
+	}
+
+	if !plan.GroupNames.IsNull() {
+		var names []string
+		diags.Append(plan.GroupNames.ElementsAs(ctx, &names, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		groups, err := resolveDeviceGroupIDs(r.client, names)
+		if err != nil {
+			diags.AddAttributeError(path.Root("group_names"), "Error Resolving Device Group Names", err.Error())
+			return diags
+		}
+		payload.Groups = groups
+	}
+
+	if !plan.LocationNames.IsNull() {
+		var names []string
+		diags.Append(plan.LocationNames.ElementsAs(ctx, &names, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		locations, err := resolveLocationIDs(r.client, names)
+		if err != nil {
+			diags.AddAttributeError(path.Root("location_names"), "Error Resolving Location Names", err.Error())
+			return diags
+		}
+		payload.Locations = locations
+	}
+
+	return diags
+}
+
+// refreshTargets maps the alert rule devices, groups, and locations into the model.
+// Targets configured by name are mapped back to names, so that renames in LibreNMS show as drift.
+func (r *alertRuleResource) refreshTargets(ctx context.Context, state *alertRuleModel, alertRule *librenms.AlertRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Devices = types.SetNull(types.Int32Type)
+	if !state.DeviceHostnames.IsNull() {
+		state.DeviceHostnames = types.SetNull(types.StringType)
+		if len(alertRule.Devices) > 0 {
+			hostnames, err := resolveDeviceHostnames(r.client, alertRule.Devices)
+			if err != nil {
+				diags.AddError("Error Resolving Alert Rule Devices", err.Error())
+				return diags
+			}
+			state.DeviceHostnames, diags = types.SetValueFrom(ctx, types.StringType, hostnames)
+		}
+	} else if len(alertRule.Devices) > 0 {
+		state.Devices, diags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Devices)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Groups = types.SetNull(types.Int32Type)
+	if !state.GroupNames.IsNull() {
+		state.GroupNames = types.SetNull(types.StringType)
+		if len(alertRule.Groups) > 0 {
+			names, err := resolveDeviceGroupNames(r.client, alertRule.Groups)
+			if err != nil {
+				diags.AddError("Error Resolving Alert Rule Groups", err.Error())
+				return diags
+			}
+			state.GroupNames, diags = types.SetValueFrom(ctx, types.StringType, names)
+		}
+	} else if len(alertRule.Groups) > 0 {
+		state.Groups, diags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Groups)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	state.Locations = types.SetNull(types.Int32Type)
+	if !state.LocationNames.IsNull() {
+		state.LocationNames = types.SetNull(types.StringType)
+		if len(alertRule.Locations) > 0 {
+			names, err := resolveLocationNames(r.client, alertRule.Locations)
+			if err != nil {
+				diags.AddError("Error Resolving Alert Rule Locations", err.Error())
+				return diags
+			}
+			state.LocationNames, diags = types.SetValueFrom(ctx, types.StringType, names)
+		}
+	} else if len(alertRule.Locations) > 0 {
+		state.Locations, diags = types.SetValueFrom(ctx, types.Int32Type, alertRule.Locations)
+	}
+
+	return diags
+}
+
+// applyTransports maps the alert rule to the planned transports and transport groups.
+// An empty mapping sends alerts to the default transports.
+func (r *alertRuleResource) applyTransports(ctx context.Context, plan *alertRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	transports := make([]int, 0)
+	diags.Append(plan.Transports.ElementsAs(ctx, &transports, false)...)
+	transportGroups := make([]int, 0)
+	diags.Append(plan.TransportGroups.ElementsAs(ctx, &transportGroups, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := r.client.SetAlertRuleTransports(int(plan.ID.ValueInt32()), transports, transportGroups)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Alert Rule Transports",
+			fmt.Sprintf("Could not set transports for alert rule ID %d: %s", plan.ID.ValueInt32(), err),
+		)
+	}
+
+	return diags
+}
+
+// refreshTransports maps the alert rule transports and transport groups into the model.
+func (r *alertRuleResource) refreshTransports(ctx context.Context, state *alertRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	transportsResp, err := r.client.GetAlertRuleTransports(int(state.ID.ValueInt32()))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Alert Rule Transports",
+			fmt.Sprintf("Could not read transports for alert rule ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return diags
+	}
+
+	if transportsResp == nil {
+		diags.AddError(
+			"Error Reading Alert Rule Transports",
+			"Received nil response when reading alert rule transports. Please check the LibreNMS API.",
+		)
+		return diags
+	}
+
+	state.Transports = types.SetNull(types.Int32Type)
+	if len(transportsResp.TransportIDs) > 0 {
+		state.Transports, diags = types.SetValueFrom(ctx, types.Int32Type, transportsResp.TransportIDs)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	state.TransportGroups = types.SetNull(types.Int32Type)
+	if len(transportsResp.TransportGroupIDs) > 0 {
+		state.TransportGroups, diags = types.SetValueFrom(ctx, types.Int32Type, transportsResp.TransportGroupIDs)
+	}
+
+	return diags
+}
+
+// alertRuleExtra holds the advanced options stored in the alert rule extra field.
+// Older LibreNMS versions omit options that were never set, so every option is optional.
+type alertRuleExtra struct {
+	Acknowledgement *extraBool `json:"acknowledgement"`
+	Recovery        *extraBool `json:"recovery"`
+	Options         struct {
+		OverrideQuery *extraBool `json:"override_query"`
+	} `json:"options"`
+}
+
+// parseAlertRuleExtra parses the serialized JSON alert rule extra field.
+func parseAlertRuleExtra(extra string) (*alertRuleExtra, error) {
+	var parsed alertRuleExtra
+	if extra == "" {
+		return &parsed, nil
+	}
+
+	if err := json.Unmarshal([]byte(extra), &parsed); err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// apply maps the options present in extra into the model.
+func (e *alertRuleExtra) apply(state *alertRuleModel) {
+	if e.Acknowledgement != nil {
+		state.Acknowledgement = types.BoolValue(bool(*e.Acknowledgement))
+	}
+	if e.Recovery != nil {
+		state.Recovery = types.BoolValue(bool(*e.Recovery))
+	}
+	if e.Options.OverrideQuery != nil {
+		state.OverrideQuery = types.BoolValue(bool(*e.Options.OverrideQuery))
+	}
+}
+
+// extraBool is a flag in the alert rule extra field. Depending on how the rule was saved,
+// LibreNMS stores flags as JSON booleans, as numbers, or as "on"/"off" form values.
+type extraBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *extraBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = extraBool(v)
+	case float64:
+		*b = v != 0
+	case string:
+		switch v {
+		case "on", "true", "1":
+			*b = true
+		case "", "off", "false", "0":
+			*b = false
+		default:
+			return fmt.Errorf("unexpected flag value %q", v)
+		}
+	case nil:
+		*b = false
+	default:
+		return fmt.Errorf("unexpected flag value %s", string(data))
+	}
+	return nil
+}
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/jokelyo/go-librenms"
 )
 
 const alertRuleSetupConfig = `
@@ -52,6 +59,18 @@ resource "librenms_location" "test_location" {
   latitude = -45.0862462
   longitude = 37.4220648
 }
+resource "librenms_alert_transport" "test_transport" {
+  name = "test alertrule transport"
+
+  mail = {
+    email = "noc@example.com"
+  }
+}
+resource "librenms_alert_transport_group" "test_transport_group" {
+  name          = "test alertrule transport group"
+  transport_ids = [librenms_alert_transport.test_transport.id]
+}
+
 resource "librenms_location" "test_location2" {
   name = "test location 2"
 
@@ -191,6 +210,10 @@ resource "librenms_alertrule" "testrule3" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "name", "Test Rule (ICMP)"),
 					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "severity", "critical"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "invert", "false"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "recovery", "true"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "acknowledgement", "true"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "override_query", "false"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("librenms_alertrule.testrule", "id"),
 					resource.TestCheckResourceAttrSet("librenms_alertrule.testrule", "query"),
@@ -242,6 +265,9 @@ resource "librenms_alertrule" "testrule" {
   interval   = "5m"
   max_alerts = 3
 
+  override_query = true
+  query          = "SELECT * FROM devices WHERE (devices.device_id = ?) AND devices.status = 0"
+
   disabled = false
   severity = "critical"
 }
@@ -277,6 +303,10 @@ resource "librenms_alertrule" "testrule2" {
   interval   = "5m"
   max_alerts = 1
 
+  invert          = true
+  recovery        = false
+  acknowledgement = false
+
   disabled = false
   severity = "critical"
 }
@@ -314,6 +344,9 @@ resource "librenms_alertrule" "testrule3" {
   disabled = false
   severity = "warning"
 
+  transport_ids       = [librenms_alert_transport.test_transport.id]
+  transport_group_ids = [librenms_alert_transport_group.test_transport_group.id]
+
   groups = [
 	librenms_devicegroup.test2.id,
 	librenms_devicegroup.test1.id
@@ -328,13 +361,299 @@ resource "librenms_alertrule" "testrule3" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify testrule updated
 					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "max_alerts", "3"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "override_query", "true"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule", "query", "SELECT * FROM devices WHERE (devices.device_id = ?) AND devices.status = 0"),
 					// Verify testrule2 updated
 					resource.TestCheckResourceAttr("librenms_alertrule.testrule2", "devices.#", "0"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule2", "invert", "true"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule2", "recovery", "false"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule2", "acknowledgement", "false"),
 					// Verify testrule3 updated
 					resource.TestCheckResourceAttr("librenms_alertrule.testrule3", "severity", "warning"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule3", "transport_ids.#", "1"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule3", "transport_group_ids.#", "1"),
+					// rules without transports use the default transports
+					resource.TestCheckNoResourceAttr("librenms_alertrule.testrule2", "transport_ids"),
+				),
+			},
+			// Reference groups and locations by name instead of ID
+			{
+				Config: providerConfig + alertRuleSetupConfig + `
+resource "librenms_alertrule" "testrule3" {
+  name  = "Test Rule (ICMP) Location Set"
+
+  builder = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "macros.device_down",
+        "field" : "macros.device_down",
+        "type" : "integer",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "1"
+      },
+      {
+        "id" : "devices.status_reason",
+        "field" : "devices.status_reason",
+        "type" : "string",
+        "input" : "text",
+        "operator" : "equal",
+        "value" : "icmp"
+      }
+    ],
+    "valid" : true
+  })
+
+  delay      = "11m"
+  interval   = "5m"
+  max_alerts = 1
+
+  disabled = false
+  severity = "warning"
+
+  transport_ids       = [librenms_alert_transport.test_transport.id]
+  transport_group_ids = [librenms_alert_transport_group.test_transport_group.id]
+
+  # Reference groups and locations by name
+  group_names = [
+    librenms_devicegroup.test2.name,
+    librenms_devicegroup.test1.name
+  ]
+
+  location_names = [
+    librenms_location.test_location.name,
+    librenms_location.test_location2.name
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule3", "group_names.#", "2"),
+					resource.TestCheckResourceAttr("librenms_alertrule.testrule3", "location_names.#", "2"),
+					resource.TestCheckNoResourceAttr("librenms_alertrule.testrule3", "groups"),
+					resource.TestCheckNoResourceAttr("librenms_alertrule.testrule3", "locations"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccAlertRuleResource_uniqueName(t *testing.T) {
+	ruleConfig := `
+resource "librenms_alertrule" "%s" {
+  name        = "Test Rule (Unique Name)"
+  disabled    = true
+  severity    = "warning"
+  unique_name = %t
+
+  builder = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "macros.device_down",
+        "field" : "macros.device_down",
+        "type" : "integer",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "1"
+      }
+    ],
+    "valid" : true
+  })
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// a rule checks its name against every rule except itself
+			{
+				Config: providerConfig + fmt.Sprintf(ruleConfig, "first", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_alertrule.first", "unique_name", "true"),
+					resource.TestCheckResourceAttrSet("librenms_alertrule.first", "id"),
+				),
+			},
+			{
+				Config:      providerConfig + fmt.Sprintf(ruleConfig, "first", true) + fmt.Sprintf(ruleConfig, "second", true),
+				ExpectError: regexp.MustCompile(`Duplicate Alert Rule Name`),
+			},
+		},
+	})
+}
+
+func TestParseAlertRuleExtra(t *testing.T) {
+	tests := map[string]struct {
+		extra    string
+		expected alertRuleModel
+	}{
+		"json booleans": {
+			extra: `{"mute":false,"count":1,"delay":300,"invert":true,"interval":300,"recovery":false,"acknowledgement":true,"options":{"override_query":false}}`,
+			expected: alertRuleModel{
+				Acknowledgement: types.BoolValue(true),
+				// the extra invert flag inverts the rule match, it is not the invert_map of the invert attribute
+				Invert:        types.BoolNull(),
+				OverrideQuery: types.BoolValue(false),
+				Recovery:      types.BoolValue(false),
+			},
+		},
+		"form values": {
+			extra: `{"invert":"0","recovery":"1","acknowledgement":"off","options":{"override_query":"on"}}`,
+			expected: alertRuleModel{
+				Acknowledgement: types.BoolValue(false),
+				Invert:          types.BoolNull(),
+				OverrideQuery:   types.BoolValue(true),
+				Recovery:        types.BoolValue(true),
+			},
+		},
+		"missing options": {
+			extra: `{"mute":false,"count":"-1","delay":60}`,
+			expected: alertRuleModel{
+				Acknowledgement: types.BoolNull(),
+				Invert:          types.BoolNull(),
+				OverrideQuery:   types.BoolNull(),
+				Recovery:        types.BoolNull(),
+			},
+		},
+		"empty": {
+			extra: "",
+			expected: alertRuleModel{
+				Acknowledgement: types.BoolNull(),
+				Invert:          types.BoolNull(),
+				OverrideQuery:   types.BoolNull(),
+				Recovery:        types.BoolNull(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			extra, err := parseAlertRuleExtra(tc.extra)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			state := alertRuleModel{
+				Acknowledgement: types.BoolNull(),
+				Invert:          types.BoolNull(),
+				OverrideQuery:   types.BoolNull(),
+				Recovery:        types.BoolNull(),
+			}
+			extra.apply(&state)
+
+			if !state.Acknowledgement.Equal(tc.expected.Acknowledgement) {
+				t.Errorf("acknowledgement: expected %s, got %s", tc.expected.Acknowledgement, state.Acknowledgement)
+			}
+			if !state.Invert.Equal(tc.expected.Invert) {
+				t.Errorf("invert: expected %s, got %s", tc.expected.Invert, state.Invert)
+			}
+			if !state.OverrideQuery.Equal(tc.expected.OverrideQuery) {
+				t.Errorf("override_query: expected %s, got %s", tc.expected.OverrideQuery, state.OverrideQuery)
+			}
+			if !state.Recovery.Equal(tc.expected.Recovery) {
+				t.Errorf("recovery: expected %s, got %s", tc.expected.Recovery, state.Recovery)
+			}
+		})
+	}
+
+	t.Run("invalid flag", func(t *testing.T) {
+		if _, err := parseAlertRuleExtra(`{"recovery":"maybe"}`); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	})
+}
+
+func TestMatchCreatedAlertRule(t *testing.T) {
+	payload := &librenms.AlertRuleCreateRequest{
+		Name:     "Devices up/down",
+		Severity: "critical",
+		Builder:  `{"condition": "AND", "rules": [{"field": "macros.device_down", "operator": "equal", "value": "1"}]}`,
+	}
+	builder := `{"condition":"AND","rules":[{"id":"macros.device_down","field":"macros.device_down","type":"integer","input":"radio","operator":"equal","value":"1"}],"valid":true}`
+
+	tests := map[string]struct {
+		rules      []librenms.AlertRule
+		maxPriorID int
+		expectedID int
+		expectErr  bool
+	}{
+		"new rule": {
+			rules: []librenms.AlertRule{
+				{ID: 3, Name: "Port status up/down", Severity: "warning", Builder: builder},
+				{ID: 7, Name: "Devices up/down", Severity: "critical", Builder: builder},
+			},
+			maxPriorID: 6,
+			expectedID: 7,
+		},
+		"existing rule with the same name": {
+			rules: []librenms.AlertRule{
+				{ID: 2, Name: "Devices up/down", Severity: "critical", Builder: builder},
+				{ID: 7, Name: "Devices up/down", Severity: "critical", Builder: builder},
+			},
+			maxPriorID: 6,
+			expectedID: 7,
+		},
+		"concurrent rule with a different builder": {
+			rules: []librenms.AlertRule{
+				{ID: 7, Name: "Devices up/down", Severity: "critical", Builder: builder},
+				{ID: 8, Name: "Devices up/down", Severity: "critical", Builder: `{"condition":"AND","rules":[]}`},
+			},
+			maxPriorID: 6,
+			expectedID: 7,
+		},
+		"concurrent rule with a different severity": {
+			rules: []librenms.AlertRule{
+				{ID: 7, Name: "Devices up/down", Severity: "warning", Builder: builder},
+				{ID: 8, Name: "Devices up/down", Severity: "critical", Builder: builder},
+			},
+			maxPriorID: 6,
+			expectedID: 8,
+		},
+		"several new matching rules": {
+			rules: []librenms.AlertRule{
+				{ID: 9, Name: "Devices up/down", Severity: "critical", Builder: builder},
+				{ID: 7, Name: "Devices up/down", Severity: "critical", Builder: builder},
+				{ID: 8, Name: "Devices up/down", Severity: "critical", Builder: builder},
+			},
+			maxPriorID: 6,
+			expectErr:  true,
+		},
+		"only existing rules": {
+			rules: []librenms.AlertRule{
+				{ID: 2, Name: "Devices up/down", Severity: "critical", Builder: builder},
+			},
+			maxPriorID: 6,
+			expectErr:  true,
+		},
+		"not found": {
+			maxPriorID: 6,
+			expectErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule, err := matchCreatedAlertRule(context.Background(), tc.rules, payload, tc.maxPriorID)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got rule ID %d", rule.ID)
+				}
+				// the candidates are listed, so the correct rule can be imported
+				for _, r := range tc.rules {
+					if r.ID > tc.maxPriorID && !strings.Contains(err.Error(), fmt.Sprintf("ID %d", r.ID)) {
+						t.Errorf("expected rule ID %d to be listed, got %q", r.ID, err)
+					}
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if rule.ID != tc.expectedID {
+				t.Errorf("expected rule ID %d, got %d", tc.expectedID, rule.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// alertStates maps the alert state names to their LibreNMS values.
+var alertStates = map[string]int{
+	"ok":           0,
+	"alert":        1,
+	"acknowledged": 2,
+	"worse":        3,
+	"better":       4,
+	"changed":      5,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &alertsDataSource{}
+	_ datasource.DataSourceWithConfigure = &alertsDataSource{}
+)
+
+// NewAlertsDataSource is a helper function to simplify the provider implementation.
+func NewAlertsDataSource() datasource.DataSource {
+	return &alertsDataSource{}
+}
+
+type (
+	// alertsDataSource is the data source implementation.
+	alertsDataSource struct {
+		client *librenms.Client
+	}
+
+	// alertsModel maps data source schema data to a Go type.
+	alertsModel struct {
+		Alerts   []alertModel `tfsdk:"alerts"`
+		DeviceID types.Int32  `tfsdk:"device_id"`
+		RuleID   types.Int32  `tfsdk:"rule_id"`
+		Severity types.String `tfsdk:"severity"`
+		State    types.String `tfsdk:"state"`
+	}
+
+	// alertModel maps an alert to a Go type.
+	alertModel struct {
+		DeviceID  types.Int32  `tfsdk:"device_id"`
+		Hostname  types.String `tfsdk:"hostname"`
+		ID        types.Int32  `tfsdk:"id"`
+		Note      types.String `tfsdk:"note"`
+		RuleID    types.Int32  `tfsdk:"rule_id"`
+		RuleName  types.String `tfsdk:"rule_name"`
+		Severity  types.String `tfsdk:"severity"`
+		State     types.String `tfsdk:"state"`
+		Timestamp types.String `tfsdk:"timestamp"`
+	}
+)
+
+// Metadata returns the data source type name.
+func (d *alertsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerts"
+}
+
+// Schema defines the schema for the data source.
+func (d *alertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LibreNMS alerts. By default, all active alerts are returned.",
+		Attributes: map[string]schema.Attribute{
+			"alerts": schema.ListNestedAttribute{
+				Description: "The matching alerts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_id": schema.Int32Attribute{
+							Description: "The ID of the alerting device.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "The hostname of the alerting device.",
+							Computed:    true,
+						},
+						"id": schema.Int32Attribute{
+							Description: "The unique numeric identifier of the alert.",
+							Computed:    true,
+						},
+						"note": schema.StringAttribute{
+							Description: "The alert note, such as the acknowledgement note.",
+							Computed:    true,
+						},
+						"rule_id": schema.Int32Attribute{
+							Description: "The ID of the alert rule that raised the alert.",
+							Computed:    true,
+						},
+						"rule_name": schema.StringAttribute{
+							Description: "The name of the alert rule that raised the alert.",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "The alert severity [`ok`, `warning`, `critical`].",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The alert state [`ok`, `alert`, `acknowledged`, `worse`, `better`, `changed`].",
+							Computed:    true,
+						},
+						"timestamp": schema.StringAttribute{
+							Description: "The time of the last alert state change, as reported by LibreNMS.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"device_id": schema.Int32Attribute{
+				Description: "If set, only alerts of this device are returned.",
+				Optional:    true,
+			},
+			"rule_id": schema.Int32Attribute{
+				Description: "If set, only alerts raised by this alert rule are returned.",
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "If set, only alerts with this severity are returned [`ok`, `warning`, `critical`].",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ok", "warning", "critical"),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "If set, only alerts in this state are returned [`ok`, `alert`, `acknowledged`, `worse`, `better`, `changed`]." +
+					" If not set, alerts in any state other than `ok` are returned.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ok", "alert", "acknowledged", "worse", "better", "changed"),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the data source.
+func (d *alertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *alertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state alertsModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alertsResp, err := d.client.GetAlerts(alertsQuery(state))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alerts",
+			fmt.Sprintf("Could not read LibreNMS alerts: %s", err.Error()),
+		)
+		return
+	}
+
+	if alertsResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Alerts",
+			"Received nil response when reading alerts. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	state.Alerts = make([]alertModel, 0, len(alertsResp.Alerts))
+	for _, alert := range alertsResp.Alerts {
+		state.Alerts = append(state.Alerts, alertModel{
+			DeviceID:  types.Int32Value(int32(alert.DeviceID)),
+			Hostname:  types.StringValue(alert.Hostname),
+			ID:        types.Int32Value(int32(alert.ID)),
+			Note:      types.StringValue(alert.Note),
+			RuleID:    types.Int32Value(int32(alert.RuleID)),
+			RuleName:  types.StringValue(alert.Name),
+			Severity:  types.StringValue(alert.Severity),
+			State:     types.StringValue(alertStateName(alert.State)),
+			Timestamp: types.StringValue(alert.Timestamp),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// alertsQuery builds the LibreNMS alerts query for the data source filters. Every filter is sent to the API,
+// including the default of all states other than `ok`, so `ok` is always an explicit state value.
+func alertsQuery(filters alertsModel) *librenms.AlertsQuery {
+	query := &librenms.AlertsQuery{
+		DeviceID: int(filters.DeviceID.ValueInt32()),
+		Severity: filters.Severity.ValueString(),
+		RuleID:   int(filters.RuleID.ValueInt32()),
+	}
+	if filters.State.IsNull() {
+		for _, value := range alertStates {
+			if value != alertStates["ok"] {
+				query.States = append(query.States, value)
+			}
+		}
+		slices.Sort(query.States)
+	} else {
+		query.States = []int{alertStates[filters.State.ValueString()]}
+	}
+	return query
+}
+
+// alertStateName returns the name of a LibreNMS alert state, or the number itself if the state is unknown.
+func alertStateName(state int) string {
+	for name, value := range alertStates {
+		if value == state {
+			return name
+		}
+	}
+	return strconv.Itoa(state)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAlertsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_alertrule" "test" {
+  name     = "test alerts lookup"
+  disabled = true
+  severity = "warning"
+  builder  = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.status",
+        "field" : "devices.status",
+        "type" : "boolean",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "0"
+      }
+    ],
+    "valid" : true
+  })
+}
+
+data "librenms_alerts" "active" {}
+
+data "librenms_alerts" "test" {
+  rule_id  = librenms_alertrule.test.id
+  state    = "alert"
+  severity = "warning"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.librenms_alerts.active", "alerts.#"),
+					resource.TestCheckResourceAttr("data.librenms_alerts.test", "alerts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlertStateName(t *testing.T) {
+	for name, value := range alertStates {
+		if got := alertStateName(value); got != name {
+			t.Errorf("alertStateName(%d): expected %q, got %q", value, name, got)
+		}
+	}
+
+	if got := alertStateName(42); got != "42" {
+		t.Errorf("alertStateName(42): expected %q, got %q", "42", got)
+	}
+}
+
+func TestAlertsQuery(t *testing.T) {
+	tests := map[string]struct {
+		filters        alertsModel
+		expectedStates []int
+	}{
+		"default": {
+			filters:        alertsModel{State: types.StringNull()},
+			expectedStates: []int{1, 2, 3, 4, 5},
+		},
+		"ok": {
+			filters:        alertsModel{State: types.StringValue("ok")},
+			expectedStates: []int{0},
+		},
+		"alert": {
+			filters:        alertsModel{State: types.StringValue("alert")},
+			expectedStates: []int{1},
+		},
+		"changed": {
+			filters:        alertsModel{State: types.StringValue("changed")},
+			expectedStates: []int{5},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			query := alertsQuery(tc.filters)
+			if !slices.Equal(query.States, tc.expectedStates) {
+				t.Errorf("expected states %v, got %v", tc.expectedStates, query.States)
+			}
+			if query.DeviceID != 0 || query.RuleID != 0 || query.Severity != "" {
+				t.Errorf("expected no other filters, got %+v", query)
+			}
+		})
+	}
+
+	query := alertsQuery(alertsModel{
+		DeviceID: types.Int32Value(3),
+		RuleID:   types.Int32Value(7),
+		Severity: types.StringValue("critical"),
+		State:    types.StringValue("acknowledged"),
+	})
+	if query.DeviceID != 3 || query.RuleID != 7 || query.Severity != "critical" || !slices.Equal(query.States, []int{2}) {
+		t.Errorf("expected every filter to be passed to the API, got %+v", query)
+	}
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceDependencyResource{}
+	_ resource.ResourceWithConfigure   = &deviceDependencyResource{}
+	_ resource.ResourceWithImportState = &deviceDependencyResource{}
+	_ resource.ResourceWithModifyPlan  = &deviceDependencyResource{}
+)
+
+// NewDeviceDependencyResource is a helper function to simplify the provider implementation.
+func NewDeviceDependencyResource() resource.Resource {
+	return &deviceDependencyResource{}
+}
+
+type (
+	// deviceDependencyResource is the resource implementation.
+	deviceDependencyResource struct {
+		client *librenms.Client
+	}
+
+	// deviceDependencyModel maps resource schema data to a Go type.
+	deviceDependencyModel struct {
+		ID        types.Int32 `tfsdk:"id"`
+		DeviceID  types.Int32 `tfsdk:"device_id"`
+		ParentIDs types.Set   `tfsdk:"parent_ids"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *deviceDependencyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_dependency"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceDependencyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the dependency, which is the child device ID.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.Int32Attribute{
+				Description: "The ID of the child device.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"parent_ids": schema.SetAttribute{
+				Description: "The set of parent device IDs. Alerts for the device are suppressed while its parents are down." +
+					" A device cannot be its own parent, and dependency cycles with the parents already set in LibreNMS are rejected during plan.",
+				Required:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the resource configuration.
+func (r *deviceDependencyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data deviceDependencyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeviceID.IsNull() || data.DeviceID.IsUnknown() || data.ParentIDs.IsUnknown() {
+		return
+	}
+
+	// a device cannot depend on itself
+	for _, v := range data.ParentIDs.Elements() {
+		parentID, ok := v.(types.Int32)
+		if ok && parentID.Equal(data.DeviceID) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parent_ids"),
+				"Invalid Device Dependency",
+				fmt.Sprintf("Device %d cannot be its own parent.", data.DeviceID.ValueInt32()),
+			)
+			return
+		}
+	}
+}
+
+// ModifyPlan rejects parent devices that would create a dependency cycle.
+// The cycle check walks the parents currently set in LibreNMS, so a cycle formed only by dependencies that are
+// created or changed in the same plan is not detected.
+func (r *deviceDependencyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan deviceDependencyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeviceID.IsUnknown() || plan.ParentIDs.IsUnknown() {
+		return
+	}
+
+	parentIDs, diags := dependencyParentIDs(ctx, plan.ParentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cycle, err := findDependencyCycle(int(plan.DeviceID.ValueInt32()), parentIDs, r.getParentIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device Dependencies",
+			fmt.Sprintf("Could not check device dependencies for cycles: %s", err.Error()),
+		)
+		return
+	}
+
+	if cycle != nil {
+		trail := make([]string, 0, len(cycle))
+		for _, id := range cycle {
+			trail = append(trail, strconv.Itoa(id))
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_ids"),
+			"Device Dependency Cycle",
+			fmt.Sprintf("Adding these parents to device %d would create a dependency cycle: %s.",
+				plan.DeviceID.ValueInt32(), strings.Join(trail, " -> ")),
+		)
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *deviceDependencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceDependencyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentIDs, diags := dependencyParentIDs(ctx, plan.ParentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceIdentifier := strconv.Itoa(int(plan.DeviceID.ValueInt32()))
+	_, err := r.client.AddDeviceParents(deviceIdentifier, parentIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Device Dependency",
+			fmt.Sprintf("Could not add parents to device %s: %s", deviceIdentifier, err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.DeviceID
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceDependencyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	parentIDs, err := r.getParentIDs(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device Dependency",
+			fmt.Sprintf("Could not read parents for LibreNMS device ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	// the dependency no longer exists if the device has no parents
+	if len(parentIDs) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.DeviceID = state.ID
+	state.ParentIDs, diags = types.SetValueFrom(ctx, types.Int32Type, parentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deviceDependencyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state deviceDependencyModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentIDs, diags := dependencyParentIDs(ctx, plan.ParentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// LibreNMS replaces the parents of the device with the given set, so the full planned set is sent
+	deviceIdentifier := strconv.Itoa(int(state.ID.ValueInt32()))
+	_, err := r.client.AddDeviceParents(deviceIdentifier, parentIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Device Dependency",
+			fmt.Sprintf("Could not set parents of device %s: %s", deviceIdentifier, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceDependencyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentIDs, diags := dependencyParentIDs(ctx, state.ParentIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the parents from the device
+	_, err := r.client.DeleteDeviceParents(strconv.Itoa(int(state.ID.ValueInt32())), parentIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Device Dependency",
+			"Could not remove device parents, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *deviceDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing ID for Import",
+			fmt.Sprintf("Expected a numeric device ID for import, but got %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
+
+// getParentIDs retrieves the parent device IDs of a device from the LibreNMS API.
+func (r *deviceDependencyResource) getParentIDs(deviceID int) ([]int, error) {
+	parentsResp, err := r.client.GetDeviceParents(strconv.Itoa(deviceID))
+	if err != nil {
+		return nil, err
+	}
+
+	if parentsResp == nil {
+		return nil, fmt.Errorf("received nil response when reading parents for device %d", deviceID)
+	}
+
+	return parentsResp.ParentIDs, nil
+}
+
+// dependencyParentIDs converts the parent ID set to a sorted slice.
+func dependencyParentIDs(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	ids := make([]int, 0, len(set.Elements()))
+	diags := set.ElementsAs(ctx, &ids, false)
+	sort.Ints(ids)
+	return ids, diags
+}
+
+// findDependencyCycle walks the ancestors of the planned parents and returns the dependency path if the child device
+// is one of them. The parentsOf function returns the current parents of a device.
+func findDependencyCycle(childID int, parentIDs []int, parentsOf func(int) ([]int, error)) ([]int, error) {
+	visited := map[int]bool{childID: true}
+
+	var walk func(id int, trail []int) ([]int, error)
+	walk = func(id int, trail []int) ([]int, error) {
+		trail = append(trail, id)
+		if id == childID {
+			return trail, nil
+		}
+		if visited[id] {
+			return nil, nil
+		}
+		visited[id] = true
+
+		parents, err := parentsOf(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, parentID := range parents {
+			cycle, err := walk(parentID, trail)
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	for _, parentID := range parentIDs {
+		cycle, err := walk(parentID, []int{childID})
+		if cycle != nil || err != nil {
+			return cycle, err
+		}
+	}
+
+	return nil, nil
+}
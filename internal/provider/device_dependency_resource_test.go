@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const deviceDependencySetupConfig = `
+resource "librenms_device" "switch" {
+  hostname  = "192.168.6.1"
+  icmp_only = {}
+  force_add = true
+}
+
+resource "librenms_device" "router" {
+  hostname  = "192.168.6.2"
+  icmp_only = {}
+  force_add = true
+}
+
+resource "librenms_device" "server" {
+  hostname  = "192.168.6.3"
+  icmp_only = {}
+  force_add = true
+}
+`
+
+func TestAccDeviceDependencyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + deviceDependencySetupConfig + `
+resource "librenms_device_dependency" "server" {
+  device_id  = librenms_device.server.id
+  parent_ids = [librenms_device.switch.id]
+}
+
+resource "librenms_device_dependency" "switch" {
+  device_id  = librenms_device.switch.id
+  parent_ids = [librenms_device.router.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("librenms_device_dependency.server", "id", "librenms_device.server", "id"),
+					resource.TestCheckResourceAttr("librenms_device_dependency.server", "parent_ids.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "librenms_device_dependency.server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + deviceDependencySetupConfig + `
+resource "librenms_device_dependency" "server" {
+  device_id  = librenms_device.server.id
+  parent_ids = [librenms_device.switch.id, librenms_device.router.id]
+}
+
+resource "librenms_device_dependency" "switch" {
+  device_id  = librenms_device.switch.id
+  parent_ids = [librenms_device.router.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device_dependency.server", "parent_ids.#", "2"),
+				),
+			},
+			// Replace a parent, which keeps only the planned parents
+			{
+				Config: providerConfig + deviceDependencySetupConfig + `
+resource "librenms_device_dependency" "server" {
+  device_id  = librenms_device.server.id
+  parent_ids = [librenms_device.router.id]
+}
+
+resource "librenms_device_dependency" "switch" {
+  device_id  = librenms_device.switch.id
+  parent_ids = [librenms_device.router.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device_dependency.server", "parent_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("librenms_device_dependency.server", "parent_ids.*", "librenms_device.router", "id"),
+				),
+			},
+			// A cycle through existing dependencies is rejected during plan
+			{
+				Config: providerConfig + deviceDependencySetupConfig + `
+resource "librenms_device_dependency" "server" {
+  device_id  = librenms_device.server.id
+  parent_ids = [librenms_device.router.id]
+}
+
+resource "librenms_device_dependency" "switch" {
+  device_id  = librenms_device.switch.id
+  parent_ids = [librenms_device.router.id]
+}
+
+resource "librenms_device_dependency" "router" {
+  device_id  = librenms_device.router.id
+  parent_ids = [librenms_device.server.id]
+}
+`,
+				ExpectError: regexp.MustCompile(`Device Dependency Cycle`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceDependencyResource_selfParent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_device_dependency" "test" {
+  device_id  = 1
+  parent_ids = [2, 1]
+}
+`,
+				ExpectError: regexp.MustCompile(`cannot be its own parent`),
+			},
+		},
+	})
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	// 1 -> 2 -> 3 means device 1 has parent 2, which has parent 3
+	graph := map[int][]int{
+		1: {2},
+		2: {3},
+		3: {},
+		4: {2, 5},
+		5: {},
+	}
+	parentsOf := func(id int) ([]int, error) {
+		return graph[id], nil
+	}
+
+	tests := map[string]struct {
+		child   int
+		parents []int
+		want    []int
+	}{
+		"no cycle":             {child: 6, parents: []int{4}, want: nil},
+		"direct cycle":         {child: 2, parents: []int{1}, want: []int{2, 1, 2}},
+		"transitive cycle":     {child: 3, parents: []int{5, 4}, want: []int{3, 4, 2, 3}},
+		"unrelated parents":    {child: 5, parents: []int{1, 3}, want: nil},
+		"replaces own parents": {child: 2, parents: []int{5}, want: nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := findDependencyCycle(tc.child, tc.parents, parentsOf)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected cycle %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		_, err := findDependencyCycle(1, []int{2}, func(int) ([]int, error) {
+			return nil, fmt.Errorf("api error")
+		})
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &deviceDiscoverAction{}
+	_ action.ActionWithConfigure = &deviceDiscoverAction{}
+)
+
+// NewDeviceDiscoverAction is a helper function to simplify the provider implementation.
+func NewDeviceDiscoverAction() action.Action {
+	return &deviceDiscoverAction{}
+}
+
+type (
+	// deviceDiscoverAction is the action implementation.
+	deviceDiscoverAction struct {
+		client *librenms.Client
+	}
+
+	// deviceDiscoverModel maps action schema data to a Go type.
+	deviceDiscoverModel struct {
+		Device types.String `tfsdk:"device"`
+	}
+)
+
+// Metadata returns the action type name.
+func (a *deviceDiscoverAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_discover"
+}
+
+// Schema defines the schema for the action.
+func (a *deviceDiscoverAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Queues a device for rediscovery by LibreNMS.",
+		Attributes: map[string]schema.Attribute{
+			"device": schema.StringAttribute{
+				Description: "The hostname or numeric ID of the device to rediscover.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the action.
+func (a *deviceDiscoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke queues the device for rediscovery.
+func (a *deviceDiscoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config deviceDiscoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device := config.Device.ValueString()
+	_, err := a.client.DiscoverDevice(device)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Discovering Device",
+			fmt.Sprintf("Could not queue device %q for discovery: %s", device, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Queued device %q for discovery", device),
+	})
+}
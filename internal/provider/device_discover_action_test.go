@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestAccDeviceDiscoverAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(testAccActionsMinVersion),
+		},
+		Steps: []resource.TestStep{
+			// Invoke by hostname after the device is created
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.10.1"
+  icmp_only = {}
+  force_add = true
+}
+
+action "librenms_device_discover" "test" {
+  config {
+    device = librenms_device.test_device.hostname
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = librenms_device.test_device.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.librenms_device_discover.test]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.trigger", "id"),
+					testAccCheckDeviceDiscoveryRequested("librenms_device.test_device"),
+				),
+			},
+			// Invoke by numeric ID when the trigger is updated
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.10.1"
+  icmp_only = {}
+  force_add = true
+}
+
+action "librenms_device_discover" "test" {
+  config {
+    device = tostring(librenms_device.test_device.id)
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "${librenms_device.test_device.id}-by-id"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.librenms_device_discover.test]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.trigger", "id"),
+					testAccCheckDeviceDiscoveryRequested("librenms_device.test_device"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceDiscoverAction_unknownDevice(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(testAccActionsMinVersion),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+action "librenms_device_discover" "test" {
+  config {
+    device = "no-such-device.invalid"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "discover"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.librenms_device_discover.test]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Error Discovering Device`),
+			},
+		},
+	})
+}
+
+// testAccCheckDeviceDiscoveryRequested verifies that the device is queued for discovery. LibreNMS requests a
+// discovery by clearing the last discovery timestamp, and the test instance does not run discovery for ICMP-only
+// devices, so the timestamp stays unset until the next discovery run.
+func testAccCheckDeviceDiscoveryRequested(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+
+		client, err := librenms.New("http://localhost:8000", os.Getenv("LIBRENMS_TOKEN"))
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetDevice(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(resp.Devices) != 1 {
+			return fmt.Errorf("expected 1 device for %s, got %d", rs.Primary.ID, len(resp.Devices))
+		}
+		if resp.Devices[0].LastDiscovered != nil {
+			return fmt.Errorf("expected device %s to be queued for discovery, last discovered at %s", rs.Primary.ID, *resp.Devices[0].LastDiscovered)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// maintenanceBehaviors maps the maintenance behavior names to their LibreNMS values.
+var maintenanceBehaviors = map[string]int{
+	"skip_alerts": 1,
+	"mute_alerts": 2,
+	"run_alerts":  3,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action                   = &deviceMaintenanceAction{}
+	_ action.ActionWithConfigure      = &deviceMaintenanceAction{}
+	_ action.ActionWithValidateConfig = &deviceMaintenanceAction{}
+)
+
+// NewDeviceMaintenanceAction is a helper function to simplify the provider implementation.
+func NewDeviceMaintenanceAction() action.Action {
+	return &deviceMaintenanceAction{}
+}
+
+type (
+	// deviceMaintenanceAction is the action implementation.
+	deviceMaintenanceAction struct {
+		client *librenms.Client
+	}
+
+	// deviceMaintenanceModel maps action schema data to a Go type.
+	deviceMaintenanceModel struct {
+		Behavior    types.String `tfsdk:"behavior"`
+		Device      types.String `tfsdk:"device"`
+		DeviceGroup types.String `tfsdk:"device_group"`
+		Duration    types.String `tfsdk:"duration"`
+		Notes       types.String `tfsdk:"notes"`
+		Title       types.String `tfsdk:"title"`
+	}
+)
+
+// Metadata returns the action type name.
+func (a *deviceMaintenanceAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_maintenance"
+}
+
+// Schema defines the schema for the action.
+func (a *deviceMaintenanceAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Puts a device or a device group into maintenance, starting now." +
+			" LibreNMS creates a maintenance schedule that expires after the duration.",
+		Attributes: map[string]schema.Attribute{
+			"behavior": schema.StringAttribute{
+				Description: "How alerts are handled during maintenance [`skip_alerts`, `mute_alerts`, `run_alerts`]. Defaults to `skip_alerts`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("skip_alerts", "mute_alerts", "run_alerts"),
+				},
+			},
+			"device": schema.StringAttribute{
+				Description: "The hostname or numeric ID of the device to put into maintenance. Conflicts with `device_group`.",
+				Optional:    true,
+			},
+			"device_group": schema.StringAttribute{
+				Description: "The name or numeric ID of the device group to put into maintenance. Conflicts with `device`.",
+				Optional:    true,
+			},
+			"duration": schema.StringAttribute{
+				Description: "The duration of the maintenance, in a format like `30m` or `2h`. It is rounded up to whole minutes.",
+				Required:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "The maintenance notes.",
+				Optional:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the maintenance schedule. If not set, LibreNMS generates one.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the maintenance target and duration.
+func (a *deviceMaintenanceAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data deviceMaintenanceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Device.IsUnknown() && !data.DeviceGroup.IsUnknown() && data.Device.IsNull() == data.DeviceGroup.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("device"),
+			"Invalid Maintenance Target",
+			"Exactly one of device or device_group must be set.",
+		)
+	}
+
+	if data.Duration.IsUnknown() {
+		return
+	}
+
+	if _, err := maintenanceDuration(data.Duration.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration"),
+			"Invalid Duration Value",
+			err.Error(),
+		)
+	}
+}
+
+// Configure sets the provider client for the action.
+func (a *deviceMaintenanceAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke puts the device or device group into maintenance.
+func (a *deviceMaintenanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config deviceMaintenanceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	duration, err := maintenanceDuration(config.Duration.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("duration"), "Invalid Duration Value", err.Error())
+		return
+	}
+
+	payload := &librenms.MaintenanceRequest{
+		Title:    config.Title.ValueString(),
+		Notes:    config.Notes.ValueString(),
+		Duration: duration,
+		Behavior: maintenanceBehaviors["skip_alerts"],
+	}
+	if !config.Behavior.IsNull() {
+		payload.Behavior = maintenanceBehaviors[config.Behavior.ValueString()]
+	}
+
+	if !config.Device.IsNull() {
+		device := config.Device.ValueString()
+		if _, err := a.client.MaintenanceDevice(device, payload); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Starting Device Maintenance",
+				fmt.Sprintf("Could not put device %q into maintenance: %s", device, err),
+			)
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Device %q is in maintenance for %s", device, duration),
+		})
+		return
+	}
+
+	group := config.DeviceGroup.ValueString()
+	if _, err := a.client.MaintenanceDeviceGroup(group, payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Device Group Maintenance",
+			fmt.Sprintf("Could not put device group %q into maintenance: %s", group, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Device group %q is in maintenance for %s", group, duration),
+	})
+}
+
+// maintenanceDuration converts a duration like `90m` to the `H:MM` format used by LibreNMS, rounding up to whole minutes.
+func maintenanceDuration(value string) (string, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return "", fmt.Errorf("the duration must be in a format like `30m` or `2h`: %w", err)
+	}
+
+	if duration <= 0 {
+		return "", fmt.Errorf("the duration must be positive, got %q", value)
+	}
+
+	minutes := int((duration + time.Minute - 1) / time.Minute)
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60), nil
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestAccDeviceMaintenanceAction(t *testing.T) {
+	const title = "terraform acceptance maintenance"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(testAccActionsMinVersion),
+		},
+		CheckDestroy: testAccDeleteMaintenanceSchedules(title),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.10.2"
+  icmp_only = {}
+  force_add = true
+}
+
+action "librenms_device_maintenance" "test" {
+  config {
+    device   = librenms_device.test_device.hostname
+    duration = "1h"
+    title    = "` + title + `"
+    notes    = "created by the acceptance tests"
+    behavior = "mute_alerts"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = librenms_device.test_device.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.librenms_device_maintenance.test]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeviceInMaintenance("librenms_device.test_device", title),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckDeviceInMaintenance verifies that LibreNMS has a maintenance schedule with the title for the device.
+func testAccCheckDeviceInMaintenance(name, title string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		deviceID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := librenms.New("http://localhost:8000", os.Getenv("LIBRENMS_TOKEN"))
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetAlertSchedules()
+		if err != nil {
+			return err
+		}
+		for _, schedule := range resp.Schedules {
+			if schedule.Title == title && slices.Contains(schedule.Devices, deviceID) {
+				return nil
+			}
+		}
+		return fmt.Errorf("no maintenance schedule %q found for device %d", title, deviceID)
+	}
+}
+
+// testAccDeleteMaintenanceSchedules removes the maintenance schedules the action test created, since they are not
+// managed by Terraform and would otherwise be left in LibreNMS.
+func testAccDeleteMaintenanceSchedules(title string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := librenms.New("http://localhost:8000", os.Getenv("LIBRENMS_TOKEN"))
+		if err != nil {
+			return err
+		}
+		resp, err := client.GetAlertSchedules()
+		if err != nil {
+			return err
+		}
+		for _, schedule := range resp.Schedules {
+			if schedule.Title != title {
+				continue
+			}
+			if _, err := client.DeleteAlertSchedule(schedule.ID); err != nil {
+				return fmt.Errorf("unable to delete maintenance schedule %d: %w", schedule.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func TestDeviceMaintenanceActionValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	maintenance := NewDeviceMaintenanceAction()
+
+	schemaResp := &action.SchemaResponse{}
+	maintenance.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", diags)
+	}
+
+	tests := map[string]struct {
+		device      any
+		deviceGroup any
+		duration    string
+		expectError bool
+	}{
+		"device": {
+			device:   "core1.example.com",
+			duration: "2h",
+		},
+		"device group": {
+			deviceGroup: "core switches",
+			duration:    "90m",
+		},
+		"no target": {
+			duration:    "2h",
+			expectError: true,
+		},
+		"both targets": {
+			device:      "core1.example.com",
+			deviceGroup: "core switches",
+			duration:    "2h",
+			expectError: true,
+		},
+		"unknown target": {
+			device:   tftypes.UnknownValue,
+			duration: "2h",
+		},
+		"invalid duration": {
+			device:      "core1.example.com",
+			duration:    "two hours",
+			expectError: true,
+		},
+		"negative duration": {
+			device:      "core1.example.com",
+			duration:    "-1h",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"behavior":     tftypes.NewValue(tftypes.String, nil),
+					"device":       tftypes.NewValue(tftypes.String, tc.device),
+					"device_group": tftypes.NewValue(tftypes.String, tc.deviceGroup),
+					"duration":     tftypes.NewValue(tftypes.String, tc.duration),
+					"notes":        tftypes.NewValue(tftypes.String, nil),
+					"title":        tftypes.NewValue(tftypes.String, nil),
+				}),
+			}
+
+			resp := &action.ValidateConfigResponse{}
+			maintenance.(action.ActionWithValidateConfig).ValidateConfig(ctx, action.ValidateConfigRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMaintenanceDuration(t *testing.T) {
+	tests := map[string]string{
+		"30m":   "0:30",
+		"2h":    "2:00",
+		"90m":   "1:30",
+		"1h30s": "1:01",
+		"26h":   "26:00",
+	}
+
+	for value, expected := range tests {
+		t.Run(value, func(t *testing.T) {
+			duration, err := maintenanceDuration(value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if duration != expected {
+				t.Errorf("expected %q, got %q", expected, duration)
+			}
+		})
+	}
+}
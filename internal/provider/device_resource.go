@@ -2,10 +2,18 @@ package provider
 
 import (
 	"context"
+	_ "embed"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -13,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -25,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
 	"github.com/jokelyo/go-librenms"
 )
 
@@ -32,6 +43,25 @@ const (
 	snmpV1  = "v1"
 	snmpV2C = "v2c"
 	snmpV3  = "v3"
+
+	attributesModeAdditive      = "additive"
+	attributesModeAuthoritative = "authoritative"
+
+	deletionPolicyAbandon = "abandon"
+	deletionPolicyDelete  = "delete"
+	deletionPolicyDisable = "disable"
+
+	// LibreNMS stores per-device module overrides as device attributes with these prefixes.
+	pollerModulePrefix    = "poll_"
+	discoveryModulePrefix = "discover_"
+)
+
+var (
+	//go:embed data/modules.json
+	modulesJSON []byte
+
+	// knownModules is the list of LibreNMS poller and discovery modules that can be overridden per device.
+	knownModules = mustLoadKnownModules()
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -39,6 +69,7 @@ var (
 	_ resource.Resource                = &deviceResource{}
 	_ resource.ResourceWithConfigure   = &deviceResource{}
 	_ resource.ResourceWithImportState = &deviceResource{}
+	_ resource.ResourceWithModifyPlan  = &deviceResource{}
 )
 
 // NewDeviceResource is a helper function to simplify the provider implementation.
@@ -55,12 +86,25 @@ type (
 	// deviceResourceModel maps resource schema data to a Go type.
 	deviceResourceModel struct {
 		ID                  types.Int32          `tfsdk:"id"`
+		AdoptExisting       types.Bool           `tfsdk:"adopt_existing"`
+		Attributes          types.Map            `tfsdk:"attributes"`
+		AttributesMode      types.String         `tfsdk:"attributes_mode"`
+		DeletionPolicy      types.String         `tfsdk:"deletion_policy"`
+		Disabled            types.Bool           `tfsdk:"disabled"`
+		DisableNotify       types.Bool           `tfsdk:"disable_notify"`
+		EffectiveAlertRules types.Set            `tfsdk:"effective_alert_rule_ids"`
+		DiscoveryModules    types.Map            `tfsdk:"discovery_modules"`
 		Display             types.String         `tfsdk:"display"`
 		ForceAdd            types.Bool           `tfsdk:"force_add"`
+		GroupIDs            types.Set            `tfsdk:"group_ids"`
 		Hostname            types.String         `tfsdk:"hostname"`
+		Ignore              types.Bool           `tfsdk:"ignore"`
 		Location            types.String         `tfsdk:"location"`
+		LocationID          types.Int32          `tfsdk:"location_id"`
+		Notes               types.String         `tfsdk:"notes"`
 		OverrideSysLocation types.Bool           `tfsdk:"override_syslocation"`
 		PollerGroup         types.Int32          `tfsdk:"poller_group"`
+		PollerModules       types.Map            `tfsdk:"poller_modules"`
 		Port                types.Int32          `tfsdk:"port"`
 		PortAssociationMode types.Int32          `tfsdk:"port_association_mode"`
 		Transport           types.String         `tfsdk:"transport"`
@@ -90,6 +134,12 @@ type (
 		CryptoPass      types.String `tfsdk:"crypto_pass"`
 	}
 
+	// librenmsModules is the list of known LibreNMS poller and discovery modules.
+	librenmsModules struct {
+		Poller    []string `json:"poller"`
+		Discovery []string `json:"discovery"`
+	}
+
 	// deviceICMPOnlyModel maps ICMP-only configuration data to a Go type.
 	deviceICMPOnlyModel struct {
 		Hardware types.String `tfsdk:"hardware"`
@@ -114,6 +164,66 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int32planmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "If true and a device with the same hostname already exists in LibreNMS, such as one added by auto-discovery," +
+					" it will be adopted instead of created, and the configured attributes will be applied to it. Only relevant during creation.",
+				Optional: true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "A map of LibreNMS device attributes, such as `override_Oxidized_disable`." +
+					" How keys not present in this map are handled is controlled by `attributes_mode`." +
+					" Module overrides (`poll_*` and `discover_*`) must be set with `poller_modules` and `discovery_modules` instead.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"attributes_mode": schema.StringAttribute{
+				Computed: true,
+				Description: "How `attributes` are managed [`additive`, `authoritative`]. In `additive` mode, only the configured keys are managed." +
+					" In `authoritative` mode, any device attribute not in `attributes` is removed, and all of them are removed if `attributes` is not set." +
+					" Defaults to `additive`.",
+				Optional: true,
+				Default:  stringdefault.StaticString(attributesModeAdditive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(attributesModeAdditive, attributesModeAuthoritative),
+				},
+			},
+			"deletion_policy": schema.StringAttribute{
+				Computed: true,
+				Description: "What happens to the device in LibreNMS when the resource is destroyed [`delete`, `disable`, `abandon`]." +
+					" `delete` removes the device and its RRD history, `disable` keeps the device and its history but stops polling it," +
+					" and `abandon` only removes the device from the Terraform state. LibreNMS cannot delete a device and keep its history," +
+					" so there is no `keep_history` policy; use `disable` or `abandon` to keep the history. Defaults to `delete`.",
+				Optional: true,
+				Default:  stringdefault.StaticString(deletionPolicyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(deletionPolicyDelete, deletionPolicyDisable, deletionPolicyAbandon),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If true, the device will be disabled and LibreNMS will stop polling it.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disable_notify": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If true, alert notifications will not be sent for the device.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discovery_modules": schema.MapAttribute{
+				Description: "A map of discovery module names to enabled state, overriding the global and OS module settings for this device." +
+					" Only the listed modules are managed; removing a module restores its default.",
+				Optional:    true,
+				ElementType: types.BoolType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(knownModules.Discovery...)),
+				},
+			},
 			"display": schema.StringAttribute{
 				Computed:    true,
 				Description: "An optional device display name to use instead of hostname.",
@@ -122,18 +232,44 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"group_ids": schema.SetAttribute{
+				Computed:    true,
+				Description: "The set of device group IDs the device is a member of, including dynamic device groups.",
+				ElementType: types.Int32Type,
+			},
 			"hostname": schema.StringAttribute{
 				Description: "The device hostname or IP address. If hostname, it must have a valid DNS entry.",
 				Required:    true,
 			},
-			"location": schema.StringAttribute{
+			"ignore": schema.BoolAttribute{
 				Computed:    true,
-				Description: "The name of the device's location. It defaults to the discovered location name.",
+				Description: "If true, the device will be ignored by LibreNMS; alerts and status changes are suppressed, but polling continues.",
 				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"location": schema.StringAttribute{
+				Computed: true,
+				Description: "The name of the device's location. It defaults to the discovered location name." +
+					" Conflicts with `location_id`, which is preferred for locations managed by `librenms_location`.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"location_id": schema.Int32Attribute{
+				Description: "The ID of the device's location. The location name is resolved at apply time, so renaming the location" +
+					" does not break the association. Conflicts with `location`.",
+				Optional: true,
+			},
+			"effective_alert_rule_ids": schema.SetAttribute{
+				Computed: true,
+				Description: "The set of enabled alert rule IDs that apply to the device, either globally or through the device," +
+					" one of its device groups, or its location. Inverted rules apply to all devices except the selected ones." +
+					" The value is read when the device is created, updated or refreshed, so alert rules created or changed later in the same apply are reflected on the next refresh.",
+				ElementType: types.Int32Type,
+			},
 			"force_add": schema.BoolAttribute{
 				Optional:    true,
 				Description: "If true, the SNMP/ICMP checks will be skipped, and the device will be added immediately. Only relevant during creation.",
@@ -141,6 +277,14 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"notes": schema.StringAttribute{
+				Computed:    true,
+				Description: "Free-form notes for the device.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"override_syslocation": schema.BoolAttribute{
 				Computed:    true,
 				Description: "If true, the device will override the sysLocation value with the one set in LibreNMS.",
@@ -151,7 +295,7 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"poller_group": schema.Int32Attribute{
 				Computed:    true,
-				Description: "The ID of the poller group to assign this device to. If not set, the default poller group will be used (typically 0).",
+				Description: "The ID of the poller group to assign this device to. If not set, the default poller group will be used (typically 0). A poller group that does not exist is reported during plan.",
 				Optional:    true,
 				Validators: []validator.Int32{
 					int32validator.Between(0, 65535),
@@ -160,6 +304,15 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int32planmodifier.UseStateForUnknown(),
 				},
 			},
+			"poller_modules": schema.MapAttribute{
+				Description: "A map of poller module names to enabled state, overriding the global and OS module settings for this device." +
+					" Only the listed modules are managed; removing a module restores its default.",
+				Optional:    true,
+				ElementType: types.BoolType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(knownModules.Poller...)),
+				},
+			},
 			"port": schema.Int32Attribute{
 				Computed:    true,
 				Description: "The SNMP port to use for this device. If not set, the default SNMP port defined in your LibreNMS config will be used.",
@@ -310,6 +463,10 @@ func (r *deviceResource) ConfigValidators(ctx context.Context) []resource.Config
 			path.MatchRoot("snmp_v2c"),
 			path.MatchRoot("snmp_v3"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("location"),
+			path.MatchRoot("location_id"),
+		),
 	}
 }
 
@@ -322,6 +479,17 @@ func (r *deviceResource) ValidateConfig(ctx context.Context, req resource.Valida
 		return
 	}
 
+	// module overrides are managed by poller_modules and discovery_modules, so they cannot be set as attributes
+	for k := range data.Attributes.Elements() {
+		if isModuleOverrideAttribute(k) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attributes").AtMapKey(k),
+				"Invalid Device Attribute",
+				fmt.Sprintf("The device attribute %q is a module override. Use `poller_modules` or `discovery_modules` instead.", k),
+			)
+		}
+	}
+
 	// if forceAdd is true, then warn the user that ICMP/SNNP checks will be skipped on the host
 	if data.ForceAdd.ValueBool() {
 		resp.Diagnostics.AddWarning(
@@ -332,6 +500,71 @@ func (r *deviceResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
+// ModifyPlan adjusts the planned values of computed attributes that depend on other attributes.
+// It also checks that the referenced poller group exists.
+func (r *deviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan deviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateLocationID := types.Int32Null()
+	statePollerGroup := types.Int32Null()
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("location_id"), &stateLocationID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("poller_group"), &statePollerGroup)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The location name is resolved from the location ID at apply time.
+	if !plan.LocationID.IsNull() && !plan.LocationID.Equal(stateLocationID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("location"), types.StringUnknown())...)
+	}
+
+	// Poller group 0 is the default group, which always exists.
+	if plan.PollerGroup.IsUnknown() || plan.PollerGroup.IsNull() || plan.PollerGroup.ValueInt32() == 0 ||
+		plan.PollerGroup.Equal(statePollerGroup) || r.client == nil {
+		return
+	}
+
+	groupsResp, err := r.client.GetPollerGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Poller Groups",
+			fmt.Sprintf("Could not get poller groups to check that poller group %d exists: %s", plan.PollerGroup.ValueInt32(), err),
+		)
+		return
+	}
+
+	if groupsResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Poller Groups",
+			"Received nil response when getting poller groups. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	for _, group := range groupsResp.PollerGroups {
+		if group.ID == int(plan.PollerGroup.ValueInt32()) {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("poller_group"),
+		"Poller Group Not Found",
+		fmt.Sprintf("No LibreNMS poller group with ID %d exists. Use 0 for the default poller group, or reference a librenms_poller_group resource or data source.", plan.PollerGroup.ValueInt32()),
+	)
+}
+
 // Configure sets the provider client for the resource.
 func (r *deviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -360,81 +593,30 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Create the device using the LibreNMS client.
-	payload := &librenms.DeviceCreateRequest{
-		Hostname: plan.Hostname.ValueString(),
-	}
-
-	// Set optional fields
-	if !plan.Display.IsNull() {
-		payload.Display = plan.Display.ValueString()
-	}
-	if !plan.Location.IsNull() {
-		payload.Location = plan.Location.ValueString()
-	}
-	if !plan.OverrideSysLocation.IsNull() {
-		payload.OverrideSysLocation = plan.OverrideSysLocation.ValueBool()
-	}
-	if !plan.PollerGroup.IsNull() {
-		payload.PollerGroup = int(plan.PollerGroup.ValueInt32())
-	}
-	if !plan.Port.IsNull() {
-		payload.Port = int(plan.Port.ValueInt32())
-	}
-	if !plan.PortAssociationMode.IsNull() {
-		payload.PortAssocMode = int(plan.PortAssociationMode.ValueInt32())
-	}
-	if !plan.Transport.IsNull() {
-		payload.Transport = plan.Transport.ValueString()
-	}
-
-	if plan.ForceAdd.ValueBool() {
-		payload.ForceAdd = true
+	resp.Diagnostics.Append(r.resolveLocationName(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if plan.ICMPOnly != nil {
-		payload.SNMPDisable = true
-		if !plan.ICMPOnly.Hardware.IsNull() {
-			payload.Hardware = plan.ICMPOnly.Hardware.ValueString()
-		}
-		if !plan.ICMPOnly.OS.IsNull() {
-			payload.OS = plan.ICMPOnly.OS.ValueString()
-		}
-		if !plan.ICMPOnly.SysName.IsNull() {
-			payload.SysName = plan.ICMPOnly.SysName.ValueString()
+	// Adopt the device instead of creating it, if it already exists in LibreNMS.
+	adopted := false
+	if plan.AdoptExisting.ValueBool() {
+		adopted, diags = r.adoptDevice(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if plan.SnmpV1 != nil {
-		payload.SNMPVersion = snmpV1
-		payload.SNMPCommunity = plan.SnmpV1.Community.ValueString()
-	}
-
-	if plan.SnmpV2C != nil {
-		payload.SNMPVersion = snmpV2C
-		payload.SNMPCommunity = plan.SnmpV2C.Community.ValueString()
-	}
-
-	if plan.SnmpV3 != nil {
-		payload.SNMPVersion = snmpV3
-		payload.SNMPAuthAlgo = plan.SnmpV3.AuthAlgorithm.ValueString()
-		payload.SNMPAuthLevel = plan.SnmpV3.AuthLevel.ValueString()
-		payload.SNMPAuthName = plan.SnmpV3.AuthName.ValueString()
-		payload.SNMPAuthPass = plan.SnmpV3.AuthPass.ValueString()
-		payload.SNMPCrytoAlgo = plan.SnmpV3.CryptoAlgorithm.ValueString()
-		payload.SNMPCryptoPass = plan.SnmpV3.CryptoPass.ValueString()
-	}
-
-	if _, err := r.client.CreateDevice(payload); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Device",
-			fmt.Sprintf("Could not create device: %s", err),
-		)
-		return
+	if !adopted {
+		resp.Diagnostics.Append(r.createDevice(&plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// We need to GET the device to get all the fields, as the create response does not return all of them.
-	deviceResp, err := r.client.GetDevice(payload.Hostname)
+	deviceResp, err := r.client.GetDevice(plan.Hostname.ValueString())
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -462,6 +644,9 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int32Value(int32(deviceResp.Devices[0].DeviceID))
+	plan.Disabled = types.BoolValue(bool(deviceResp.Devices[0].Disabled))
+	plan.DisableNotify = types.BoolValue(bool(deviceResp.Devices[0].DisableNotify))
+	plan.Ignore = types.BoolValue(bool(deviceResp.Devices[0].Ignore))
 	plan.OverrideSysLocation = types.BoolValue(bool(deviceResp.Devices[0].OverrideSysLocation))
 	plan.PollerGroup = types.Int32Value(int32(deviceResp.Devices[0].PollerGroup))
 	plan.Port = types.Int32Value(int32(deviceResp.Devices[0].Port))
@@ -487,6 +672,23 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		plan.ICMPOnly.SysName = types.StringValue(deviceResp.Devices[0].SysName)
 	}
 
+	if deviceResp.Devices[0].Notes != nil {
+		plan.Notes = types.StringValue(*deviceResp.Devices[0].Notes)
+	} else {
+		plan.Notes = types.StringNull()
+	}
+
+	// Apply device attributes and module overrides, which are not supported by the device create API.
+	resp.Diagnostics.Append(r.applyDeviceAttributes(ctx, strconv.Itoa(deviceResp.Devices[0].DeviceID), &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshDeviceMemberships(ctx, &plan, deviceResp.Devices[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -532,45 +734,24 @@ func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Overwrite items with refreshed state
-	state.Hostname = types.StringValue(deviceResp.Devices[0].Hostname)
-	state.OverrideSysLocation = types.BoolValue(bool(deviceResp.Devices[0].OverrideSysLocation))
-	state.PollerGroup = types.Int32Value(int32(deviceResp.Devices[0].PollerGroup))
-	state.Port = types.Int32Value(int32(deviceResp.Devices[0].Port))
-	state.PortAssociationMode = types.Int32Value(int32(deviceResp.Devices[0].PortAssociationMode))
-	state.Transport = types.StringValue(deviceResp.Devices[0].Transport)
+	refreshDeviceState(&state, deviceResp.Devices[0])
 
-	// possibly null fields
-	if deviceResp.Devices[0].Display != nil {
-		state.Display = types.StringValue(*deviceResp.Devices[0].Display)
-	} else {
-		state.Display = types.StringNull()
+	// these are not stored in LibreNMS, so fall back to the defaults after import
+	if state.AttributesMode.IsNull() {
+		state.AttributesMode = types.StringValue(attributesModeAdditive)
+	}
+	if state.DeletionPolicy.IsNull() {
+		state.DeletionPolicy = types.StringValue(deletionPolicyDelete)
 	}
 
-	if deviceResp.Devices[0].Location != nil {
-		state.Location = types.StringValue(*deviceResp.Devices[0].Location)
-	} else {
-		state.Location = types.StringNull()
+	resp.Diagnostics.Append(r.refreshDeviceAttributes(ctx, strconv.Itoa(deviceResp.Devices[0].DeviceID), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	state.ICMPOnly = nil
-	state.SnmpV1 = nil
-	state.SnmpV2C = nil
-	state.SnmpV3 = nil
-	if deviceResp.Devices[0].SNMPDisable {
-		state.ICMPOnly = &deviceICMPOnlyModel{
-			Hardware: types.StringValue(deviceResp.Devices[0].Hardware),
-			OS:       types.StringValue(deviceResp.Devices[0].OS),
-			SysName:  types.StringValue(deviceResp.Devices[0].SysName),
-		}
-	} else {
-		switch deviceResp.Devices[0].SNMPVersion {
-		case snmpV1:
-			state.SnmpV1 = stateSNMPV1(deviceResp.Devices[0])
-		case snmpV2C:
-			state.SnmpV2C = stateSNMPV2C(deviceResp.Devices[0])
-		case snmpV3:
-			state.SnmpV3 = stateSNMPV3(deviceResp.Devices[0])
-		}
+	resp.Diagnostics.Append(r.refreshDeviceMemberships(ctx, &state, deviceResp.Devices[0])...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set refreshed state
@@ -598,26 +779,574 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	payload := new(librenms.DeviceUpdateRequest)
+	resp.Diagnostics.Append(r.resolveLocationName(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Build a payload of fields that have changed; LibreNMS API only supports partial updates.
-	if !plan.Display.Equal(state.Display) {
-		payload.Field = append(payload.Field, "display")
-		payload.Data = append(payload.Data, plan.Display.ValueString())
+	payload := deviceUpdatePayload(&plan, &state)
+
+	// If no relevant fields have changed, treat it as a no-op update.
+	if len(payload.Field) > 0 {
+		_, err := r.client.UpdateDevice(plan.Hostname.ValueString(), payload)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating LibreNMS Device",
+				"Could not update device, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
-	if !plan.Location.Equal(state.Location) {
-		payload.Field = append(payload.Field, "location")
-		payload.Data = append(payload.Data, plan.Location.ValueString())
+
+	// Device attributes and module overrides are diffed and updated per key.
+	resp.Diagnostics.Append(r.applyDeviceAttributes(ctx, strconv.Itoa(int(state.ID.ValueInt32())), &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !plan.OverrideSysLocation.Equal(state.OverrideSysLocation) {
-		payload.Field = append(payload.Field, "override_sysLocation")
-		payload.Data = append(payload.Data, librenms.Bool(plan.OverrideSysLocation.ValueBool()))
+
+	// Get updated device record from LibreNMS API
+	deviceResp, err := r.client.GetDevice(plan.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device",
+			fmt.Sprintf("Could not read LibreNMS device ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
 	}
-	if !plan.PollerGroup.Equal(state.PollerGroup) {
-		payload.Field = append(payload.Field, "poller_group")
-		payload.Data = append(payload.Data, int(plan.PollerGroup.ValueInt32()))
+
+	if deviceResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device",
+			"Received nil response when creating device. Please check the LibreNMS API.",
+		)
+		return
 	}
-	if !plan.Port.Equal(state.Port) {
+
+	if len(deviceResp.Devices) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Device Get Response",
+			fmt.Sprintf("Expected one device to be retrieved, got %d devices. Please check the LibreNMS API.", len(deviceResp.Devices)),
+		)
+		return
+	}
+
+	// Update resource state
+	plan.Disabled = types.BoolValue(bool(deviceResp.Devices[0].Disabled))
+	plan.DisableNotify = types.BoolValue(bool(deviceResp.Devices[0].DisableNotify))
+	plan.Ignore = types.BoolValue(bool(deviceResp.Devices[0].Ignore))
+	plan.OverrideSysLocation = types.BoolValue(bool(deviceResp.Devices[0].OverrideSysLocation))
+	plan.PollerGroup = types.Int32Value(int32(deviceResp.Devices[0].PollerGroup))
+	plan.Port = types.Int32Value(int32(deviceResp.Devices[0].Port))
+	plan.PortAssociationMode = types.Int32Value(int32(deviceResp.Devices[0].PortAssociationMode))
+	plan.Transport = types.StringValue(deviceResp.Devices[0].Transport)
+
+	// Check optionally null fields that may have been updated by SNMP
+	if deviceResp.Devices[0].Display != nil {
+		plan.Display = types.StringValue(*deviceResp.Devices[0].Display)
+	} else {
+		plan.Display = types.StringNull()
+	}
+
+	if deviceResp.Devices[0].Location != nil {
+		plan.Location = types.StringValue(*deviceResp.Devices[0].Location)
+	} else {
+		plan.Location = types.StringNull()
+	}
+
+	if plan.ICMPOnly != nil {
+		plan.ICMPOnly.Hardware = types.StringValue(deviceResp.Devices[0].Hardware)
+		plan.ICMPOnly.OS = types.StringValue(deviceResp.Devices[0].OS)
+		plan.ICMPOnly.SysName = types.StringValue(deviceResp.Devices[0].SysName)
+	}
+
+	if deviceResp.Devices[0].Notes != nil {
+		plan.Notes = types.StringValue(*deviceResp.Devices[0].Notes)
+	} else {
+		plan.Notes = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(r.refreshDeviceMemberships(ctx, &plan, deviceResp.Devices[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch state.DeletionPolicy.ValueString() {
+	case deletionPolicyAbandon:
+		// Leave the device in LibreNMS; it is only removed from state
+		tflog.Info(ctx, "Abandoning LibreNMS device", map[string]any{"hostname": state.Hostname.ValueString()})
+	case deletionPolicyDisable:
+		// Disable the device to stop polling, but keep its history
+		payload := &librenms.DeviceUpdateRequest{
+			Field: []string{"disabled"},
+			Data:  []interface{}{librenms.Bool(true)},
+		}
+		_, err := r.client.UpdateDevice(state.Hostname.ValueString(), payload)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Disabling LibreNMS Device",
+				"Could not disable device, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	default:
+		// Delete existing device
+		_, err := r.client.DeleteDevice(state.Hostname.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting LibreNMS Device",
+				"Could not delete device, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing ID for Import",
+			fmt.Sprintf("Expected a numeric ID for import, but got %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
+
+// createDevice adds the planned device to LibreNMS.
+func (r *deviceResource) createDevice(plan *deviceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Create the device using the LibreNMS client.
+	payload := &librenms.DeviceCreateRequest{
+		Hostname: plan.Hostname.ValueString(),
+	}
+
+	// Set optional fields
+	if !plan.Display.IsNull() {
+		payload.Display = plan.Display.ValueString()
+	}
+	if !plan.Location.IsNull() {
+		payload.Location = plan.Location.ValueString()
+	}
+	if !plan.OverrideSysLocation.IsNull() {
+		payload.OverrideSysLocation = plan.OverrideSysLocation.ValueBool()
+	}
+	if !plan.PollerGroup.IsNull() {
+		payload.PollerGroup = int(plan.PollerGroup.ValueInt32())
+	}
+	if !plan.Port.IsNull() {
+		payload.Port = int(plan.Port.ValueInt32())
+	}
+	if !plan.PortAssociationMode.IsNull() {
+		payload.PortAssocMode = int(plan.PortAssociationMode.ValueInt32())
+	}
+	if !plan.Transport.IsNull() {
+		payload.Transport = plan.Transport.ValueString()
+	}
+
+	if plan.ForceAdd.ValueBool() {
+		payload.ForceAdd = true
+	}
+
+	if plan.ICMPOnly != nil {
+		payload.SNMPDisable = true
+		if !plan.ICMPOnly.Hardware.IsNull() {
+			payload.Hardware = plan.ICMPOnly.Hardware.ValueString()
+		}
+		if !plan.ICMPOnly.OS.IsNull() {
+			payload.OS = plan.ICMPOnly.OS.ValueString()
+		}
+		if !plan.ICMPOnly.SysName.IsNull() {
+			payload.SysName = plan.ICMPOnly.SysName.ValueString()
+		}
+	}
+
+	if plan.SnmpV1 != nil {
+		payload.SNMPVersion = snmpV1
+		payload.SNMPCommunity = plan.SnmpV1.Community.ValueString()
+	}
+
+	if plan.SnmpV2C != nil {
+		payload.SNMPVersion = snmpV2C
+		payload.SNMPCommunity = plan.SnmpV2C.Community.ValueString()
+	}
+
+	if plan.SnmpV3 != nil {
+		payload.SNMPVersion = snmpV3
+		payload.SNMPAuthAlgo = plan.SnmpV3.AuthAlgorithm.ValueString()
+		payload.SNMPAuthLevel = plan.SnmpV3.AuthLevel.ValueString()
+		payload.SNMPAuthName = plan.SnmpV3.AuthName.ValueString()
+		payload.SNMPAuthPass = plan.SnmpV3.AuthPass.ValueString()
+		payload.SNMPCrytoAlgo = plan.SnmpV3.CryptoAlgorithm.ValueString()
+		payload.SNMPCryptoPass = plan.SnmpV3.CryptoPass.ValueString()
+	}
+
+	if _, err := r.client.CreateDevice(payload); err != nil {
+		diags.AddError(
+			"Error Creating Device",
+			fmt.Sprintf("Could not create device: %s", err),
+		)
+		return diags
+	}
+
+	// The device create API does not support these fields, so they are set with a follow-up update.
+	updatePayload := new(librenms.DeviceUpdateRequest)
+	if !plan.Disabled.IsNull() && !plan.Disabled.IsUnknown() {
+		updatePayload.Field = append(updatePayload.Field, "disabled")
+		updatePayload.Data = append(updatePayload.Data, librenms.Bool(plan.Disabled.ValueBool()))
+	}
+	if !plan.DisableNotify.IsNull() && !plan.DisableNotify.IsUnknown() {
+		updatePayload.Field = append(updatePayload.Field, "disable_notify")
+		updatePayload.Data = append(updatePayload.Data, librenms.Bool(plan.DisableNotify.ValueBool()))
+	}
+	if !plan.Ignore.IsNull() && !plan.Ignore.IsUnknown() {
+		updatePayload.Field = append(updatePayload.Field, "ignore")
+		updatePayload.Data = append(updatePayload.Data, librenms.Bool(plan.Ignore.ValueBool()))
+	}
+	if !plan.Notes.IsNull() && !plan.Notes.IsUnknown() {
+		updatePayload.Field = append(updatePayload.Field, "notes")
+		updatePayload.Data = append(updatePayload.Data, plan.Notes.ValueString())
+	}
+
+	if len(updatePayload.Field) > 0 {
+		if _, err := r.client.UpdateDevice(payload.Hostname, updatePayload); err != nil {
+			diags.AddError(
+				"Error Updating Device",
+				fmt.Sprintf("Device was created, but could not set additional device fields: %s", err),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// adoptDevice takes ownership of an existing LibreNMS device with the planned hostname, and reconciles the configured
+// fields through the device update API. It returns false if the device does not exist.
+func (r *deviceResource) adoptDevice(ctx context.Context, plan *deviceResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deviceResp, err := r.client.GetDevice(plan.Hostname.ValueString())
+	if isNotFoundError(err) {
+		tflog.Debug(ctx, "No existing device to adopt, creating it", map[string]any{"hostname": plan.Hostname.ValueString()})
+		return false, diags
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error Getting Device",
+			fmt.Sprintf("Could not check for an existing device to adopt: %s", err),
+		)
+		return false, diags
+	}
+
+	if deviceResp == nil {
+		diags.AddError(
+			"Error Getting Device",
+			"Received nil response when getting device. Please check the LibreNMS API.",
+		)
+		return false, diags
+	}
+
+	if len(deviceResp.Devices) == 0 {
+		tflog.Debug(ctx, "No existing device to adopt, creating it", map[string]any{"hostname": plan.Hostname.ValueString()})
+		return false, diags
+	}
+
+	if len(deviceResp.Devices) != 1 {
+		diags.AddError(
+			"Unexpected LibreNMS API Response",
+			fmt.Sprintf("Expected at most one device to be retrieved, got %d devices. Please check the LibreNMS API.", len(deviceResp.Devices)),
+		)
+		return false, diags
+	}
+
+	var existing deviceResourceModel
+	refreshDeviceState(&existing, deviceResp.Devices[0])
+
+	// unconfigured fields keep the existing device values
+	reconcile := *plan
+	if reconcile.Disabled.IsUnknown() {
+		reconcile.Disabled = existing.Disabled
+	}
+	if reconcile.DisableNotify.IsUnknown() {
+		reconcile.DisableNotify = existing.DisableNotify
+	}
+	if reconcile.Display.IsUnknown() {
+		reconcile.Display = existing.Display
+	}
+	if reconcile.Ignore.IsUnknown() {
+		reconcile.Ignore = existing.Ignore
+	}
+	if reconcile.Location.IsUnknown() {
+		reconcile.Location = existing.Location
+	}
+	if reconcile.Notes.IsUnknown() {
+		reconcile.Notes = existing.Notes
+	}
+	if reconcile.OverrideSysLocation.IsUnknown() {
+		reconcile.OverrideSysLocation = existing.OverrideSysLocation
+	}
+	if reconcile.PollerGroup.IsUnknown() {
+		reconcile.PollerGroup = existing.PollerGroup
+	}
+	if reconcile.Port.IsUnknown() {
+		reconcile.Port = existing.Port
+	}
+	if reconcile.PortAssociationMode.IsUnknown() {
+		reconcile.PortAssociationMode = existing.PortAssociationMode
+	}
+	if reconcile.Transport.IsUnknown() {
+		reconcile.Transport = existing.Transport
+	}
+
+	payload := deviceUpdatePayload(&reconcile, &existing)
+	if len(payload.Field) > 0 {
+		if _, err := r.client.UpdateDevice(plan.Hostname.ValueString(), payload); err != nil {
+			diags.AddError(
+				"Error Updating Adopted Device",
+				fmt.Sprintf("Could not reconcile existing device %s: %s", plan.Hostname.ValueString(), err),
+			)
+			return true, diags
+		}
+	}
+
+	tflog.Info(ctx, "Adopted existing LibreNMS device", map[string]any{
+		"hostname":  plan.Hostname.ValueString(),
+		"device_id": deviceResp.Devices[0].DeviceID,
+		"fields":    payload.Field,
+	})
+	diags.AddWarning(
+		"Existing Device Adopted",
+		fmt.Sprintf("Device %s already exists in LibreNMS (ID %d) and was adopted instead of created. "+
+			"Configured attributes were reconciled with the existing device.", plan.Hostname.ValueString(), deviceResp.Devices[0].DeviceID),
+	)
+
+	return true, diags
+}
+
+// refreshDeviceMemberships sets the device group and effective alert rule IDs for the device.
+func (r *deviceResource) refreshDeviceMemberships(ctx context.Context, state *deviceResourceModel, device librenms.Device) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupIDs := make([]int, 0)
+	groups := make(map[int]bool)
+
+	groupsResp, err := r.client.GetDeviceGroupsForDevice(strconv.Itoa(device.DeviceID))
+	// LibreNMS responds with 404 "Found no device groups" for a device without groups
+	if err != nil && !isNotFoundError(err) {
+		diags.AddError(
+			"Error Reading Device Groups",
+			fmt.Sprintf("Could not read device groups for LibreNMS device ID %d: %s", device.DeviceID, err.Error()),
+		)
+		return diags
+	}
+
+	if err == nil {
+		if groupsResp == nil {
+			diags.AddError(
+				"Error Reading Device Groups",
+				"Received nil response when reading device groups. Please check the LibreNMS API.",
+			)
+			return diags
+		}
+
+		for _, group := range groupsResp.Groups {
+			groupIDs = append(groupIDs, group.ID)
+			groups[group.ID] = true
+		}
+	}
+
+	rules, err := getCachedAlertRules(r.client)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Alert Rules",
+			fmt.Sprintf("Could not read alert rules: %s", err.Error()),
+		)
+		return diags
+	}
+
+	ruleIDs := make([]int, 0)
+	for _, rule := range rules {
+		if alertRuleAppliesToDevice(rule, device, groups) {
+			ruleIDs = append(ruleIDs, rule.ID)
+		}
+	}
+
+	var d diag.Diagnostics
+	state.GroupIDs, d = types.SetValueFrom(ctx, types.Int32Type, groupIDs)
+	diags.Append(d...)
+	state.EffectiveAlertRules, d = types.SetValueFrom(ctx, types.Int32Type, ruleIDs)
+	diags.Append(d...)
+
+	return diags
+}
+
+// alertRuleAppliesToDevice returns true if the alert rule is enabled and bound to the device directly, through one of
+// its device groups, or through its location. Rules without any bindings apply to all devices, and inverted rules
+// (invert_map) apply to all devices except the bound ones.
+func alertRuleAppliesToDevice(rule librenms.AlertRule, device librenms.Device, groups map[int]bool) bool {
+	if bool(rule.Disabled) {
+		return false
+	}
+
+	if len(rule.Devices) == 0 && len(rule.Groups) == 0 && len(rule.Locations) == 0 {
+		return true
+	}
+
+	return alertRuleBoundToDevice(rule, device, groups) != bool(rule.InvertMap)
+}
+
+// alertRuleBoundToDevice returns true if the alert rule is bound to the device directly, through one of its device
+// groups, or through its location.
+func alertRuleBoundToDevice(rule librenms.AlertRule, device librenms.Device, groups map[int]bool) bool {
+	for _, id := range rule.Devices {
+		if id == device.DeviceID {
+			return true
+		}
+	}
+
+	for _, id := range rule.Groups {
+		if groups[id] {
+			return true
+		}
+	}
+
+	if device.LocationID != nil {
+		for _, id := range rule.Locations {
+			if id == *device.LocationID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// alertRulesCache holds the alert rules per client, so that reading many devices in the same provider run does not
+// fetch all alert rules for every device. The alert rule resource clears it whenever it changes a rule.
+var (
+	alertRulesCacheMu sync.Mutex
+	alertRulesCache   = make(map[*librenms.Client][]librenms.AlertRule)
+)
+
+// getCachedAlertRules returns all alert rules, fetching them only if they are not cached for the client.
+// Errors are not cached, so the next device retries the request.
+func getCachedAlertRules(client *librenms.Client) ([]librenms.AlertRule, error) {
+	alertRulesCacheMu.Lock()
+	defer alertRulesCacheMu.Unlock()
+
+	if rules, ok := alertRulesCache[client]; ok {
+		return rules, nil
+	}
+
+	rulesResp, err := client.GetAlertRules()
+	if err != nil {
+		return nil, err
+	}
+	if rulesResp == nil {
+		return nil, fmt.Errorf("received nil response when reading alert rules, please check the LibreNMS API")
+	}
+
+	alertRulesCache[client] = rulesResp.Rules
+	return rulesResp.Rules, nil
+}
+
+// clearCachedAlertRules removes the cached alert rules of the client, so that devices read later see the change.
+func clearCachedAlertRules(client *librenms.Client) {
+	alertRulesCacheMu.Lock()
+	defer alertRulesCacheMu.Unlock()
+
+	delete(alertRulesCache, client)
+}
+
+// resolveLocationName sets the planned location name from the configured location ID, if any.
+func (r *deviceResource) resolveLocationName(plan *deviceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.LocationID.IsNull() || plan.LocationID.IsUnknown() {
+		return diags
+	}
+
+	locationResp, err := r.client.GetLocation(int(plan.LocationID.ValueInt32()))
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("location_id"),
+			"Error Resolving Location",
+			fmt.Sprintf("Could not retrieve LibreNMS location ID %d: %s", plan.LocationID.ValueInt32(), err.Error()),
+		)
+		return diags
+	}
+
+	if locationResp == nil {
+		diags.AddAttributeError(
+			path.Root("location_id"),
+			"Error Resolving Location",
+			"Received nil response when getting location. Please check the LibreNMS API.",
+		)
+		return diags
+	}
+
+	plan.Location = types.StringValue(locationResp.Location.Name)
+	return diags
+}
+
+// deviceUpdatePayload builds a payload of the fields that differ between the plan and the prior state.
+func deviceUpdatePayload(plan, state *deviceResourceModel) *librenms.DeviceUpdateRequest {
+	payload := new(librenms.DeviceUpdateRequest)
+
+	// Build a payload of fields that have changed; LibreNMS API only supports partial updates.
+	if !plan.Disabled.Equal(state.Disabled) {
+		payload.Field = append(payload.Field, "disabled")
+		payload.Data = append(payload.Data, librenms.Bool(plan.Disabled.ValueBool()))
+	}
+	if !plan.DisableNotify.Equal(state.DisableNotify) {
+		payload.Field = append(payload.Field, "disable_notify")
+		payload.Data = append(payload.Data, librenms.Bool(plan.DisableNotify.ValueBool()))
+	}
+	if !plan.Display.Equal(state.Display) {
+		payload.Field = append(payload.Field, "display")
+		payload.Data = append(payload.Data, plan.Display.ValueString())
+	}
+	if !plan.Ignore.Equal(state.Ignore) {
+		payload.Field = append(payload.Field, "ignore")
+		payload.Data = append(payload.Data, librenms.Bool(plan.Ignore.ValueBool()))
+	}
+	if !plan.Location.Equal(state.Location) {
+		payload.Field = append(payload.Field, "location")
+		payload.Data = append(payload.Data, plan.Location.ValueString())
+	}
+	if !plan.Notes.Equal(state.Notes) {
+		payload.Field = append(payload.Field, "notes")
+		payload.Data = append(payload.Data, plan.Notes.ValueString())
+	}
+	if !plan.OverrideSysLocation.Equal(state.OverrideSysLocation) {
+		payload.Field = append(payload.Field, "override_sysLocation")
+		payload.Data = append(payload.Data, librenms.Bool(plan.OverrideSysLocation.ValueBool()))
+	}
+	if !plan.PollerGroup.Equal(state.PollerGroup) {
+		payload.Field = append(payload.Field, "poller_group")
+		payload.Data = append(payload.Data, int(plan.PollerGroup.ValueInt32()))
+	}
+	if !plan.Port.Equal(state.Port) {
 		payload.Field = append(payload.Field, "port")
 		payload.Data = append(payload.Data, int(plan.Port.ValueInt32()))
 	}
@@ -681,108 +1410,267 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		payload.Data = append(payload.Data, plan.SnmpV3.CryptoPass.ValueString())
 	}
 
-	// If no relevant fields have changed, treat it as a no-op update.
-	if len(payload.Field) > 0 {
-		_, err := r.client.UpdateDevice(plan.Hostname.ValueString(), payload)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating LibreNMS Device",
-				"Could not update device, unexpected error: "+err.Error(),
-			)
-			return
+	return payload
+}
+
+// refreshDeviceState overwrites the state with the device values returned by the LibreNMS API.
+func refreshDeviceState(state *deviceResourceModel, device librenms.Device) {
+	state.ID = types.Int32Value(int32(device.DeviceID))
+	state.Disabled = types.BoolValue(bool(device.Disabled))
+	state.DisableNotify = types.BoolValue(bool(device.DisableNotify))
+	state.Hostname = types.StringValue(device.Hostname)
+	state.Ignore = types.BoolValue(bool(device.Ignore))
+	state.OverrideSysLocation = types.BoolValue(bool(device.OverrideSysLocation))
+	state.PollerGroup = types.Int32Value(int32(device.PollerGroup))
+	state.Port = types.Int32Value(int32(device.Port))
+	state.PortAssociationMode = types.Int32Value(int32(device.PortAssociationMode))
+	state.Transport = types.StringValue(device.Transport)
+
+	// possibly null fields
+	if device.Display != nil {
+		state.Display = types.StringValue(*device.Display)
+	} else {
+		state.Display = types.StringNull()
+	}
+
+	if device.Location != nil {
+		state.Location = types.StringValue(*device.Location)
+	} else {
+		state.Location = types.StringNull()
+	}
+
+	// only refresh the location ID if it is managed, so that drift from the referenced location is detected
+	if !state.LocationID.IsNull() {
+		if device.LocationID != nil {
+			state.LocationID = types.Int32Value(int32(*device.LocationID))
+		} else {
+			state.LocationID = types.Int32Null()
 		}
 	}
 
-	// Get updated device record from LibreNMS API
-	deviceResp, err := r.client.GetDevice(plan.Hostname.ValueString())
+	if device.Notes != nil {
+		state.Notes = types.StringValue(*device.Notes)
+	} else {
+		state.Notes = types.StringNull()
+	}
+
+	state.ICMPOnly = nil
+	state.SnmpV1 = nil
+	state.SnmpV2C = nil
+	state.SnmpV3 = nil
+	if device.SNMPDisable {
+		state.ICMPOnly = &deviceICMPOnlyModel{
+			Hardware: types.StringValue(device.Hardware),
+			OS:       types.StringValue(device.OS),
+			SysName:  types.StringValue(device.SysName),
+		}
+	} else {
+		switch device.SNMPVersion {
+		case snmpV1:
+			state.SnmpV1 = stateSNMPV1(device)
+		case snmpV2C:
+			state.SnmpV2C = stateSNMPV2C(device)
+		case snmpV3:
+			state.SnmpV3 = stateSNMPV3(device)
+		}
+	}
+}
+
+// getDeviceAttributes retrieves all device attributes from the LibreNMS API.
+func (r *deviceResource) getDeviceAttributes(deviceIdentifier string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrResp, err := r.client.GetDeviceAttributes(deviceIdentifier)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Device",
-			fmt.Sprintf("Could not read LibreNMS device ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		diags.AddError(
+			"Error Reading Device Attributes",
+			fmt.Sprintf("Could not read attributes for LibreNMS device %s: %s", deviceIdentifier, err.Error()),
 		)
-		return
+		return nil, diags
 	}
 
-	if deviceResp == nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Device",
-			"Received nil response when creating device. Please check the LibreNMS API.",
+	if attrResp == nil {
+		diags.AddError(
+			"Error Reading Device Attributes",
+			"Received nil response when reading device attributes. Please check the LibreNMS API.",
 		)
-		return
+		return nil, diags
 	}
 
-	if len(deviceResp.Devices) != 1 {
-		resp.Diagnostics.AddError(
-			"Unexpected Device Get Response",
-			fmt.Sprintf("Expected one device to be retrieved, got %d devices. Please check the LibreNMS API.", len(deviceResp.Devices)),
-		)
-		return
+	return attrResp.Attributes, diags
+}
+
+// refreshDeviceAttributes updates the attributes and module overrides in state with the values from LibreNMS.
+// Only the keys already in state are refreshed, except for attributes in authoritative mode.
+func (r *deviceResource) refreshDeviceAttributes(ctx context.Context, deviceIdentifier string, state *deviceResourceModel) diag.Diagnostics {
+	if state.Attributes.IsNull() && state.PollerModules.IsNull() && state.DiscoveryModules.IsNull() {
+		return nil
+	}
+
+	remote, diags := r.getDeviceAttributes(deviceIdentifier)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !state.Attributes.IsNull() {
+		attributes := make(map[string]string)
+		if state.AttributesMode.ValueString() == attributesModeAuthoritative {
+			for k, v := range remote {
+				if !isModuleOverrideAttribute(k) {
+					attributes[k] = v
+				}
+			}
+		} else {
+			for k := range state.Attributes.Elements() {
+				if v, ok := remote[k]; ok {
+					attributes[k] = v
+				}
+			}
+		}
+
+		var d diag.Diagnostics
+		state.Attributes, d = types.MapValueFrom(ctx, types.StringType, attributes)
+		diags.Append(d...)
 	}
 
-	// Update resource state
-	plan.OverrideSysLocation = types.BoolValue(bool(deviceResp.Devices[0].OverrideSysLocation))
-	plan.PollerGroup = types.Int32Value(int32(deviceResp.Devices[0].PollerGroup))
-	plan.Port = types.Int32Value(int32(deviceResp.Devices[0].Port))
-	plan.PortAssociationMode = types.Int32Value(int32(deviceResp.Devices[0].PortAssociationMode))
-	plan.Transport = types.StringValue(deviceResp.Devices[0].Transport)
+	var d diag.Diagnostics
+	state.PollerModules, d = stateModuleOverrides(ctx, pollerModulePrefix, state.PollerModules, remote)
+	diags.Append(d...)
+	state.DiscoveryModules, d = stateModuleOverrides(ctx, discoveryModulePrefix, state.DiscoveryModules, remote)
+	diags.Append(d...)
 
-	// Check optionally null fields that may have been updated by SNMP
-	if deviceResp.Devices[0].Display != nil {
-		plan.Display = types.StringValue(*deviceResp.Devices[0].Display)
-	} else {
-		plan.Display = types.StringNull()
+	return diags
+}
+
+// applyDeviceAttributes diffs the planned attributes and module overrides against the prior state, and applies
+// the changes per key. The prior state is nil during creation.
+func (r *deviceResource) applyDeviceAttributes(ctx context.Context, deviceIdentifier string, plan, prior *deviceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := make(map[string]string)
+	existing := make(map[string]string)
+
+	if !plan.Attributes.IsNull() {
+		diags.Append(plan.Attributes.ElementsAs(ctx, &planned, false)...)
 	}
 
-	if deviceResp.Devices[0].Location != nil {
-		plan.Location = types.StringValue(*deviceResp.Devices[0].Location)
-	} else {
-		plan.Location = types.StringNull()
+	switch {
+	case plan.AttributesMode.ValueString() == attributesModeAuthoritative:
+		// authoritative mode diffs against everything in LibreNMS, so unmanaged attributes are removed,
+		// and without attributes all of them are removed, the same as with an empty map
+		remote, d := r.getDeviceAttributes(deviceIdentifier)
+		diags.Append(d...)
+		for k, v := range remote {
+			if !isModuleOverrideAttribute(k) {
+				existing[k] = v
+			}
+		}
+	case prior != nil && !prior.Attributes.IsNull() && (!plan.Attributes.IsNull() || prior.AttributesMode.ValueString() != attributesModeAuthoritative):
+		// attributes removed from the configuration are removed from the device
+		diags.Append(prior.Attributes.ElementsAs(ctx, &existing, false)...)
 	}
 
-	if plan.ICMPOnly != nil {
-		plan.ICMPOnly.Hardware = types.StringValue(deviceResp.Devices[0].Hardware)
-		plan.ICMPOnly.OS = types.StringValue(deviceResp.Devices[0].OS)
-		plan.ICMPOnly.SysName = types.StringValue(deviceResp.Devices[0].SysName)
+	diags.Append(moduleOverrideAttributes(ctx, pollerModulePrefix, plan.PollerModules, planned)...)
+	diags.Append(moduleOverrideAttributes(ctx, discoveryModulePrefix, plan.DiscoveryModules, planned)...)
+	if prior != nil {
+		diags.Append(moduleOverrideAttributes(ctx, pollerModulePrefix, prior.PollerModules, existing)...)
+		diags.Append(moduleOverrideAttributes(ctx, discoveryModulePrefix, prior.DiscoveryModules, existing)...)
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if diags.HasError() {
+		return diags
+	}
+
+	// sort keys so that the API calls are made in a stable order
+	keys := make([]string, 0, len(planned))
+	for k := range planned {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if v, ok := existing[k]; ok && v == planned[k] {
+			continue
+		}
+		if _, err := r.client.SetDeviceAttribute(deviceIdentifier, k, planned[k]); err != nil {
+			diags.AddError(
+				"Error Setting Device Attribute",
+				fmt.Sprintf("Could not set attribute %q for LibreNMS device %s: %s", k, deviceIdentifier, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	keys = keys[:0]
+	for k := range existing {
+		if _, ok := planned[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if _, err := r.client.DeleteDeviceAttribute(deviceIdentifier, k); err != nil {
+			diags.AddError(
+				"Error Deleting Device Attribute",
+				fmt.Sprintf("Could not delete attribute %q for LibreNMS device %s: %s", k, deviceIdentifier, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	return diags
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
-func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state deviceResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// moduleOverrideAttributes adds the module overrides to attributes, using the LibreNMS attribute name and value format.
+func moduleOverrideAttributes(ctx context.Context, prefix string, modules types.Map, attributes map[string]string) diag.Diagnostics {
+	if modules.IsNull() || modules.IsUnknown() {
+		return nil
 	}
 
-	// Delete existing device
-	_, err := r.client.DeleteDevice(state.Hostname.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting LibreNMS Device",
-			"Could not delete device, unexpected error: "+err.Error(),
-		)
-		return
+	var overrides map[string]bool
+	diags := modules.ElementsAs(ctx, &overrides, false)
+	for module, enabled := range overrides {
+		attributes[prefix+module] = "0"
+		if enabled {
+			attributes[prefix+module] = "1"
+		}
 	}
+	return diags
 }
 
-func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 32)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Parsing ID for Import",
-			fmt.Sprintf("Expected a numeric ID for import, but got %q: %s", req.ID, err.Error()),
-		)
-		return
+// stateModuleOverrides maps the device attributes back to the module overrides already in state.
+func stateModuleOverrides(ctx context.Context, prefix string, current types.Map, remote map[string]string) (types.Map, diag.Diagnostics) {
+	if current.IsNull() {
+		return current, nil
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+	modules := make(map[string]bool)
+	for module := range current.Elements() {
+		v, ok := remote[prefix+module]
+		if !ok {
+			continue
+		}
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			modules[module] = enabled
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.BoolType, modules)
+}
+
+// isModuleOverrideAttribute returns true if the device attribute is a poller or discovery module override.
+func isModuleOverrideAttribute(name string) bool {
+	return strings.HasPrefix(name, pollerModulePrefix) || strings.HasPrefix(name, discoveryModulePrefix)
+}
+
+// mustLoadKnownModules parses the embedded list of LibreNMS modules.
+func mustLoadKnownModules() librenmsModules {
+	var modules librenmsModules
+	if err := json.Unmarshal(modulesJSON, &modules); err != nil {
+		panic(fmt.Sprintf("unable to parse embedded LibreNMS module list: %s", err))
+	}
+	return modules
 }
 
 func stateSNMPV1(device librenms.Device) *deviceSNMPV1Model {
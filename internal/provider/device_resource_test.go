@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/jokelyo/go-librenms"
 )
 
 func TestAccDeviceResource(t *testing.T) {
@@ -23,6 +29,11 @@ resource "librenms_device" "test" {
 resource "librenms_device" "test2" {
   hostname = "1.1.1.2"
   display = "Test Device 2"
+  notes   = "Managed by Terraform"
+
+  attributes = {
+    override_Oxidized_disable = "true"
+  }
 
   snmp_v1 = {
     community = "test"
@@ -33,6 +44,8 @@ resource "librenms_device" "test2" {
 resource "librenms_device" "test3" {
   hostname = "1.1.1.3"
 
+  disable_notify = true
+
   snmp_v2c = {
     community = "test"
   }
@@ -42,6 +55,11 @@ resource "librenms_device" "test3" {
 resource "librenms_device" "test4" {
   hostname = "1.1.1.4"
 
+  poller_modules = {
+    "ports"     = false
+    "bgp-peers" = false
+  }
+
   snmp_v3 = {
     auth_algorithm = "SHA"
     auth_level = "authPriv"
@@ -56,6 +74,15 @@ resource "librenms_device" "test4" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("librenms_device.test", "hostname", "1.1.1.1"),
 					resource.TestCheckResourceAttr("librenms_device.test", "port", "161"),
+					resource.TestCheckResourceAttr("librenms_device.test", "disabled", "false"),
+					// the device is in no device group, which LibreNMS reports as 404
+					resource.TestCheckResourceAttr("librenms_device.test", "group_ids.#", "0"),
+					resource.TestCheckResourceAttr("librenms_device.test3", "disable_notify", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "notes", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "attributes.override_Oxidized_disable", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "attributes_mode", "additive"),
+					resource.TestCheckResourceAttr("librenms_device.test4", "poller_modules.%", "2"),
+					resource.TestCheckResourceAttr("librenms_device.test4", "poller_modules.ports", "false"),
 					//resource.TestCheckResourceAttr("librenms_device.test", "snmp_v2c.community", "test"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("librenms_device.test", "id"),
@@ -85,6 +112,14 @@ resource "librenms_device" "test" {
 resource "librenms_device" "test2" {
   hostname = "1.1.1.2"
   port     = 161
+  disabled = true
+  ignore   = true
+  notes    = "Decommissioned"
+
+  attributes = {
+    override_Oxidized_disable = "false"
+    override_icmp_disable     = "true"
+  }
   snmp_v1 = {
     community = "test"
   }
@@ -103,6 +138,14 @@ resource "librenms_device" "test3" {
 resource "librenms_device" "test4" {
   hostname = "1.1.1.4"
 
+  poller_modules = {
+    "ports" = true
+  }
+
+  discovery_modules = {
+    "vlans" = false
+  }
+
   snmp_v3 = {
     auth_algorithm = "SHA"
     auth_level = "authPriv"
@@ -118,10 +161,20 @@ resource "librenms_device" "test4" {
 					// Verify test device updated
 					resource.TestCheckResourceAttr("librenms_device.test", "display", "Test Device"),
 					resource.TestCheckResourceAttr("librenms_device.test", "port", "163"),
+					// Verify test2 updated
+					resource.TestCheckResourceAttr("librenms_device.test2", "disabled", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "ignore", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "disable_notify", "false"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "notes", "Decommissioned"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "attributes.%", "2"),
+					resource.TestCheckResourceAttr("librenms_device.test2", "attributes.override_Oxidized_disable", "false"),
 					// Verify test3 updated
 					resource.TestCheckResourceAttr("librenms_device.test3", "snmp_v1.community", "test"),
 					// Verify test4 updated
 					resource.TestCheckResourceAttr("librenms_device.test4", "snmp_v3.crypto_algorithm", "AES"),
+					resource.TestCheckResourceAttr("librenms_device.test4", "poller_modules.%", "1"),
+					resource.TestCheckResourceAttr("librenms_device.test4", "poller_modules.ports", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test4", "discovery_modules.vlans", "false"),
 					//resource.TestCheckResourceAttr("librenms_device.test", "snmp_v2c.community", "test2"),
 				),
 			},
@@ -129,3 +182,326 @@ resource "librenms_device" "test4" {
 		},
 	})
 }
+
+func TestAccDeviceResource_adoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a device that will be adopted later
+			{
+				Config: providerConfig + `
+resource "librenms_device" "discovered" {
+  hostname  = "1.1.1.10"
+  icmp_only = {}
+  force_add = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("librenms_device.discovered", "id"),
+				),
+			},
+			// Remove it from state, but keep it in LibreNMS
+			{
+				Config: providerConfig + `
+removed {
+  from = librenms_device.discovered
+
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+			},
+			// Adopt the existing device and reconcile the configured attributes
+			{
+				Config: providerConfig + `
+resource "librenms_device" "adopted" {
+  hostname       = "1.1.1.10"
+  display        = "Adopted Device"
+  icmp_only      = {}
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("librenms_device.adopted", "id"),
+					resource.TestCheckResourceAttr("librenms_device.adopted", "display", "Adopted Device"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceResource_deletionPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a device that is disabled instead of deleted
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname        = "1.1.1.11"
+  icmp_only       = {}
+  force_add       = true
+  deletion_policy = "disable"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test", "deletion_policy", "disable"),
+					resource.TestCheckResourceAttr("librenms_device.test", "disabled", "false"),
+				),
+			},
+			// Destroy the resource, which disables the device in LibreNMS
+			{
+				Config: providerConfig,
+			},
+			// Adopt the disabled device to verify it was kept
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname       = "1.1.1.11"
+  icmp_only      = {}
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test", "disabled", "true"),
+					resource.TestCheckResourceAttr("librenms_device.test", "deletion_policy", "delete"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceResource_pollerGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A poller group that does not exist is reported during plan
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname     = "1.1.1.12"
+  icmp_only    = {}
+  force_add    = true
+  poller_group = 65000
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Poller Group Not Found`),
+			},
+			// Assign the device to a managed poller group
+			{
+				Config: providerConfig + `
+resource "librenms_poller_group" "test" {
+  name = "test device poller group"
+}
+
+resource "librenms_device" "test" {
+  hostname     = "1.1.1.12"
+  icmp_only    = {}
+  force_add    = true
+  poller_group = librenms_poller_group.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("librenms_device.test", "poller_group", "librenms_poller_group.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceResource_authoritativeAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a device with authoritative attributes
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname        = "1.1.1.13"
+  icmp_only       = {}
+  force_add       = true
+  attributes_mode = "authoritative"
+
+  attributes = {
+    override_Oxidized_disable = "true"
+    override_icmp_disable     = "true"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test", "attributes.%", "2"),
+				),
+			},
+			// Removing the attributes map removes all attributes from the device
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname        = "1.1.1.13"
+  icmp_only       = {}
+  force_add       = true
+  attributes_mode = "authoritative"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("librenms_device.test", "attributes"),
+				),
+			},
+			// An empty map reads back all attributes left on the device
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname        = "1.1.1.13"
+  icmp_only       = {}
+  force_add       = true
+  attributes_mode = "authoritative"
+  attributes      = {}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test", "attributes.%", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceResource_memberships(t *testing.T) {
+	config := providerConfig + `
+resource "librenms_device" "test" {
+  hostname  = "1.1.1.14"
+  icmp_only = {}
+  force_add = true
+}
+
+resource "librenms_devicegroup" "test" {
+  name    = "test device memberships"
+  type    = "static"
+  devices = [librenms_device.test.id]
+}
+
+resource "librenms_alertrule" "bound" {
+  name     = "Test Rule (device memberships)"
+  severity = "critical"
+  groups   = [librenms_devicegroup.test.id]
+
+  builder = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "macros.device_down",
+        "field" : "macros.device_down",
+        "type" : "integer",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "1"
+      }
+    ],
+    "valid" : true
+  })
+}
+
+resource "librenms_alertrule" "inverted" {
+  name     = "Test Rule (device memberships, inverted)"
+  severity = "warning"
+  groups   = [librenms_devicegroup.test.id]
+  invert   = true
+
+  builder = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "macros.device_down",
+        "field" : "macros.device_down",
+        "type" : "integer",
+        "input" : "radio",
+        "operator" : "equal",
+        "value" : "1"
+      }
+    ],
+    "valid" : true
+  })
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the device, its group and the alert rules bound to the group
+			{
+				Config: config,
+			},
+			// Refresh the device, which was read before the group and the rules were created
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test", "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("librenms_device.test", "group_ids.*", "librenms_devicegroup.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("librenms_device.test", "effective_alert_rule_ids.*", "librenms_alertrule.bound", "id"),
+					testAccCheckSetExcludesPair("librenms_device.test", "effective_alert_rule_ids", "librenms_alertrule.inverted", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckSetExcludesPair verifies that the set attribute of a resource does not contain the attribute value of another resource.
+func testAccCheckSetExcludesPair(name, setKey, otherName, otherKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+		other, ok := s.RootModule().Resources[otherName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", otherName)
+		}
+
+		value := other.Primary.Attributes[otherKey]
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, setKey+".") && k != setKey+".#" && v == value {
+				return fmt.Errorf("expected %s.%s not to contain %s", name, setKey, value)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAlertRuleAppliesToDevice(t *testing.T) {
+	locationID := 7
+	device := librenms.Device{DeviceID: 1, LocationID: &locationID}
+	groups := map[int]bool{3: true}
+
+	tests := map[string]struct {
+		rule librenms.AlertRule
+		want bool
+	}{
+		"global":          {rule: librenms.AlertRule{}, want: true},
+		"device":          {rule: librenms.AlertRule{Devices: []int{2, 1}}, want: true},
+		"group":           {rule: librenms.AlertRule{Groups: []int{3}}, want: true},
+		"location":        {rule: librenms.AlertRule{Locations: []int{7}}, want: true},
+		"other device":    {rule: librenms.AlertRule{Devices: []int{2}}, want: false},
+		"other group":     {rule: librenms.AlertRule{Groups: []int{4}}, want: false},
+		"other location":  {rule: librenms.AlertRule{Locations: []int{8}}, want: false},
+		"disabled":        {rule: librenms.AlertRule{Devices: []int{1}, Disabled: true}, want: false},
+		"disabled global": {rule: librenms.AlertRule{Disabled: true}, want: false},
+		"inverted global": {rule: librenms.AlertRule{InvertMap: true}, want: true},
+		"inverted device": {rule: librenms.AlertRule{Devices: []int{1}, InvertMap: true}, want: false},
+		"inverted group":  {rule: librenms.AlertRule{Groups: []int{3}, InvertMap: true}, want: false},
+		"inverted other":  {rule: librenms.AlertRule{Devices: []int{2}, Locations: []int{8}, InvertMap: true}, want: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := alertRuleAppliesToDevice(tc.rule, device, groups); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
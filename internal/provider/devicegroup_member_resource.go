@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deviceGroupMemberResource{}
+	_ resource.ResourceWithConfigure   = &deviceGroupMemberResource{}
+	_ resource.ResourceWithImportState = &deviceGroupMemberResource{}
+)
+
+// NewDeviceGroupMemberResource is a helper function to simplify the provider implementation.
+func NewDeviceGroupMemberResource() resource.Resource {
+	return &deviceGroupMemberResource{}
+}
+
+type (
+	// deviceGroupMemberResource is the resource implementation.
+	deviceGroupMemberResource struct {
+		client *librenms.Client
+	}
+
+	// deviceGroupMemberModel maps resource schema data to a Go type.
+	deviceGroupMemberModel struct {
+		ID       types.String `tfsdk:"id"`
+		DeviceID types.Int32  `tfsdk:"device_id"`
+		GroupID  types.Int32  `tfsdk:"group_id"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *deviceGroupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devicegroup_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceGroupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the membership, in the format `<group_id>/<device_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_id": schema.Int32Attribute{
+				Description: "The ID of the device to add to the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.Int32Attribute{
+				Description: "The ID of the static device group. The group should not also manage its members, so create it with `devices = []` and ignore changes to `devices`." +
+					" The device is added and removed on its own, so separate Terraform runs can manage members of the same group.",
+				Required: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *deviceGroupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deviceGroupMemberModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := int(plan.GroupID.ValueInt32())
+	deviceID := int(plan.DeviceID.ValueInt32())
+
+	// membership can only be managed for static groups
+	groupResp, err := r.client.GetDeviceGroup(strconv.Itoa(groupID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device Group",
+			fmt.Sprintf("Could not read LibreNMS devicegroup ID %d: %s", groupID, err.Error()),
+		)
+		return
+	}
+
+	if groupResp == nil || len(groupResp.Groups) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Device Group Get Response",
+			fmt.Sprintf("Expected one device group to be retrieved for ID %d. Please check the LibreNMS API.", groupID),
+		)
+		return
+	}
+
+	if groupResp.Groups[0].Type != "static" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_id"),
+			"Invalid Device Group Type",
+			fmt.Sprintf("Device group %d is a %s group. Members can only be added to static device groups.", groupID, groupResp.Groups[0].Type),
+		)
+		return
+	}
+
+	// adding a single device leaves the other members alone, so separate applies do not overwrite each other
+	_, err = r.client.AddDevicesToDeviceGroup(strconv.Itoa(groupID), []int{deviceID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Device Group Member",
+			fmt.Sprintf("Could not add device %d to device group %d: %s", deviceID, groupID, err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(fmt.Sprintf("%d/%d", groupID, deviceID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deviceGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceGroupMemberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the membership was removed together with its group
+	groupResp, err := r.client.GetDeviceGroup(strconv.Itoa(int(state.GroupID.ValueInt32())))
+	if isNotFoundError(err) || (err == nil && groupResp != nil && len(groupResp.Groups) == 0) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device Group",
+			fmt.Sprintf("Could not read LibreNMS devicegroup ID %d: %s", state.GroupID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	members, err := getDeviceGroupMembers(r.client, int(state.GroupID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Device Group Members",
+			fmt.Sprintf("Could not read members for device group ID %d: %s", state.GroupID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	// the membership was removed outside of Terraform
+	if !slices.Contains(members, int(state.DeviceID.ValueInt32())) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All attributes require replacement, so there is nothing to update in LibreNMS.
+func (r *deviceGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan deviceGroupMemberModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state deviceGroupMemberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := int(state.GroupID.ValueInt32())
+	deviceID := int(state.DeviceID.ValueInt32())
+
+	// Remove the device from the group, the membership is gone already if the group was deleted
+	_, err := r.client.RemoveDevicesFromDeviceGroup(strconv.Itoa(groupID), []int{deviceID})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Device Group Member",
+			fmt.Sprintf("Could not remove device %d from device group %d: %s", deviceID, groupID, err),
+		)
+		return
+	}
+}
+
+func (r *deviceGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, deviceID, err := parseDeviceGroupMemberID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Parsing ID for Import",
+			fmt.Sprintf("Expected an ID in the format <group_id>/<device_id> for import, but got %q: %s", req.ID, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), types.Int32Value(groupID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_id"), types.Int32Value(deviceID))...)
+}
+
+// parseDeviceGroupMemberID parses an ID in the format `<group_id>/<device_id>`.
+func parseDeviceGroupMemberID(id string) (int32, int32, error) {
+	groupPart, devicePart, ok := strings.Cut(id, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing separator")
+	}
+
+	groupID, err := strconv.ParseInt(groupPart, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid group ID: %w", err)
+	}
+
+	deviceID, err := strconv.ParseInt(devicePart, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid device ID: %w", err)
+	}
+
+	return int32(groupID), int32(deviceID), nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const deviceGroupMemberSetupConfig = `
+resource "librenms_device" "test_device1" {
+  hostname  = "192.168.7.1"
+  icmp_only = {}
+  force_add = true
+}
+resource "librenms_device" "test_device2" {
+  hostname  = "192.168.7.2"
+  icmp_only = {}
+  force_add = true
+}
+resource "librenms_device" "test_device3" {
+  hostname  = "192.168.7.3"
+  icmp_only = {}
+  force_add = true
+}
+
+# The group is created without devices, and its members are managed separately
+resource "librenms_devicegroup" "shared" {
+  name    = "test shared group"
+  type    = "static"
+  devices = []
+
+  lifecycle {
+    ignore_changes = [devices]
+  }
+}
+`
+
+func TestAccDeviceGroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, with parallel membership changes to the same empty group
+			{
+				Config: providerConfig + deviceGroupMemberSetupConfig + `
+resource "librenms_devicegroup_member" "member1" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device1.id
+}
+resource "librenms_devicegroup_member" "member2" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device2.id
+}
+resource "librenms_devicegroup_member" "member3" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device3.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("librenms_devicegroup_member.member1", "id"),
+					resource.TestCheckResourceAttrSet("librenms_devicegroup_member.member2", "id"),
+					resource.TestCheckResourceAttrSet("librenms_devicegroup_member.member3", "id"),
+				),
+			},
+			// Verify other members were kept
+			{
+				Config: providerConfig + deviceGroupMemberSetupConfig + `
+resource "librenms_devicegroup_member" "member1" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device1.id
+}
+resource "librenms_devicegroup_member" "member2" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device2.id
+}
+resource "librenms_devicegroup_member" "member3" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device3.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_devicegroup.shared", "member_count", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "librenms_devicegroup_member.member2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing a member leaves the others in place
+			{
+				Config: providerConfig + deviceGroupMemberSetupConfig + `
+resource "librenms_devicegroup_member" "member1" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device1.id
+}
+resource "librenms_devicegroup_member" "member2" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device2.id
+}
+`,
+			},
+			{
+				Config: providerConfig + deviceGroupMemberSetupConfig + `
+resource "librenms_devicegroup_member" "member1" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device1.id
+}
+resource "librenms_devicegroup_member" "member2" {
+  group_id  = librenms_devicegroup.shared.id
+  device_id = librenms_device.test_device2.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_devicegroup.shared", "member_count", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestParseDeviceGroupMemberID(t *testing.T) {
+	groupID, deviceID, err := parseDeviceGroupMemberID("12/123")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if groupID != 12 || deviceID != 123 {
+		t.Errorf("expected 12/123, got %d/%d", groupID, deviceID)
+	}
+
+	for _, id := range []string{"12", "12/", "/123", "a/123", "12/b"} {
+		if _, _, err := parseDeviceGroupMemberID(id); err == nil {
+			t.Errorf("expected error for %q, got nil", id)
+		}
+	}
+}
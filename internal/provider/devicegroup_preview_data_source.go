@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deviceGroupPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &deviceGroupPreviewDataSource{}
+)
+
+const (
+	// deviceGroupPreviewPrefix is the name prefix of the temporary device groups, followed by the creation time in
+	// nanoseconds since the Unix epoch.
+	deviceGroupPreviewPrefix = "terraform-preview-"
+
+	// deviceGroupPreviewMaxAge is the age after which a temporary device group is considered left behind. A preview
+	// only lives for a few requests, so younger groups may belong to a preview that is still running.
+	deviceGroupPreviewMaxAge = 10 * time.Minute
+)
+
+// NewDeviceGroupPreviewDataSource is a helper function to simplify the provider implementation.
+func NewDeviceGroupPreviewDataSource() datasource.DataSource {
+	return &deviceGroupPreviewDataSource{}
+}
+
+type (
+	// deviceGroupPreviewDataSource is the data source implementation.
+	deviceGroupPreviewDataSource struct {
+		client *librenms.Client
+	}
+
+	// deviceGroupPreviewModel maps data source schema data to a Go type.
+	deviceGroupPreviewModel struct {
+		DeviceIDs types.Set    `tfsdk:"device_ids"`
+		Hostnames types.Set    `tfsdk:"hostnames"`
+		Rules     queryBuilder `tfsdk:"rules"`
+	}
+)
+
+// Metadata returns the data source type name.
+func (d *deviceGroupPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devicegroup_preview"
+}
+
+// Schema defines the schema for the data source.
+func (d *deviceGroupPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates dynamic device group rules and returns the devices that would match." +
+			" LibreNMS has no API to evaluate rules directly, so reading this data source writes to LibreNMS:" +
+			" on every plan and refresh, a temporary dynamic device group named `terraform-preview-<timestamp>` is created," +
+			" its members are read, and the group is deleted again. Preview groups older than 10 minutes, left behind by an interrupted run," +
+			" are deleted first. The API token must be allowed to create and delete device groups.",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.StringAttribute{
+				Description: "The rules to evaluate, in the same serialized JSON format as `librenms_devicegroup.rules`.",
+				Required:    true,
+				CustomType:  queryBuilderType{},
+			},
+			"device_ids": schema.SetAttribute{
+				Description: "The set of device IDs matched by the rules.",
+				Computed:    true,
+				ElementType: types.Int32Type,
+			},
+			"hostnames": schema.SetAttribute{
+				Description: "The set of device hostnames matched by the rules.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the data source.
+func (d *deviceGroupPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read evaluates the rules in a temporary device group and sets the matched devices.
+func (d *deviceGroupPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deviceGroupPreviewModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove groups left behind by previews that were interrupted before they could delete their group
+	resp.Diagnostics.Append(d.deleteStalePreviewGroups(time.Now())...)
+
+	name := fmt.Sprintf("%s%d", deviceGroupPreviewPrefix, time.Now().UnixNano())
+	description := "Temporary device group created by Terraform to preview rules."
+	rules := state.Rules.ValueString()
+
+	groupResp, err := d.client.CreateDeviceGroup(&librenms.DeviceGroupCreateRequest{
+		Name:        name,
+		Description: &description,
+		Type:        "dynamic",
+		Rules:       &rules,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Preview Device Group",
+			fmt.Sprintf("Could not create temporary device group to evaluate rules: %s", err),
+		)
+		return
+	}
+
+	// always remove the temporary group, even if reading its members fails
+	defer func() {
+		if _, err := d.client.DeleteDeviceGroup(strconv.Itoa(groupResp.ID)); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error Deleting Preview Device Group",
+				fmt.Sprintf("Could not delete temporary device group %q (ID %d), it should be removed manually: %s", name, groupResp.ID, err),
+			)
+		}
+	}()
+
+	// rules that match no devices result in an empty set, not an error
+	members, err := getDeviceGroupMembers(d.client, groupResp.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Preview Device Group Members",
+			fmt.Sprintf("Could not read devices matched by the rules: %s", err),
+		)
+		return
+	}
+
+	hostnames, err := resolveDeviceHostnames(d.client, members)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resolving Preview Device Group Members",
+			fmt.Sprintf("Could not resolve hostnames of devices matched by the rules: %s", err),
+		)
+		return
+	}
+
+	state.DeviceIDs, diags = types.SetValueFrom(ctx, types.Int32Type, members)
+	resp.Diagnostics.Append(diags...)
+	state.Hostnames, diags = types.SetValueFrom(ctx, types.StringType, hostnames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// deleteStalePreviewGroups deletes temporary device groups left behind by earlier previews. Failures are
+// reported as warnings, as they do not affect the preview itself.
+func (d *deviceGroupPreviewDataSource) deleteStalePreviewGroups(now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupsResp, err := d.client.GetDeviceGroups()
+	if err != nil || groupsResp == nil {
+		diags.AddWarning(
+			"Error Reading Device Groups",
+			fmt.Sprintf("Could not read device groups to remove temporary preview groups left behind: %v", err),
+		)
+		return diags
+	}
+
+	for _, group := range staleDeviceGroupPreviews(groupsResp.Groups, now) {
+		if _, err := d.client.DeleteDeviceGroup(strconv.Itoa(group.ID)); err != nil {
+			diags.AddWarning(
+				"Error Deleting Preview Device Group",
+				fmt.Sprintf("Could not delete temporary device group %q (ID %d), it should be removed manually: %s", group.Name, group.ID, err),
+			)
+		}
+	}
+
+	return diags
+}
+
+// staleDeviceGroupPreviews returns the temporary preview device groups that are older than deviceGroupPreviewMaxAge.
+func staleDeviceGroupPreviews(groups []librenms.DeviceGroup, now time.Time) []librenms.DeviceGroup {
+	stale := make([]librenms.DeviceGroup, 0)
+	for _, group := range groups {
+		suffix, ok := strings.CutPrefix(group.Name, deviceGroupPreviewPrefix)
+		if !ok {
+			continue
+		}
+
+		created, err := strconv.ParseInt(suffix, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if now.Sub(time.Unix(0, created)) > deviceGroupPreviewMaxAge {
+			stale = append(stale, group)
+		}
+	}
+	return stale
+}
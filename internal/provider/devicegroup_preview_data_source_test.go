@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestAccDeviceGroupPreviewDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.8.1"
+  icmp_only = {}
+  force_add = true
+}
+
+data "librenms_devicegroup_preview" "test" {
+  rules = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.hostname",
+        "field" : "devices.hostname",
+        "operator" : "equal",
+        "value" : librenms_device.test_device.hostname
+      }
+    ],
+    "joins" : [],
+    "valid" : true
+  })
+}
+
+data "librenms_devicegroup_preview" "empty" {
+  rules = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.hostname",
+        "field" : "devices.hostname",
+        "operator" : "equal",
+        "value" : "no-such-device.invalid"
+      }
+    ],
+    "joins" : [],
+    "valid" : true
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.librenms_devicegroup_preview.test", "device_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.librenms_devicegroup_preview.test", "device_ids.0", "librenms_device.test_device", "id"),
+					resource.TestCheckResourceAttr("data.librenms_devicegroup_preview.test", "hostnames.0", "192.168.8.1"),
+					resource.TestCheckResourceAttr("data.librenms_devicegroup_preview.empty", "device_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestStaleDeviceGroupPreviews(t *testing.T) {
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	groups := []librenms.DeviceGroup{
+		{ID: 1, Name: fmt.Sprintf("terraform-preview-%d", now.Add(-time.Hour).UnixNano())},
+		{ID: 2, Name: fmt.Sprintf("terraform-preview-%d", now.Add(-time.Minute).UnixNano())},
+		{ID: 3, Name: "terraform-preview-core switches"},
+		{ID: 4, Name: "core switches"},
+	}
+
+	stale := staleDeviceGroupPreviews(groups, now)
+	if len(stale) != 1 || stale[0].ID != 1 {
+		t.Errorf("expected only group 1 to be stale, got %v", stale)
+	}
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
-
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
@@ -47,12 +45,15 @@ type (
 
 	// deviceGroupModel maps resource schema data to a Go type.
 	deviceGroupModel struct {
-		ID          types.Int32          `tfsdk:"id"`
-		Name        types.String         `tfsdk:"name"`
-		Description types.String         `tfsdk:"description"`
-		Devices     types.Set            `tfsdk:"devices"`
-		Rules       jsontypes.Normalized `tfsdk:"rules"`
-		Type        types.String         `tfsdk:"type"`
+		ID              types.Int32  `tfsdk:"id"`
+		Name            types.String `tfsdk:"name"`
+		Description     types.String `tfsdk:"description"`
+		DeviceHostnames types.Set    `tfsdk:"device_hostnames"`
+		Devices         types.Set    `tfsdk:"devices"`
+		MemberCount     types.Int32  `tfsdk:"member_count"`
+		Members         types.Set    `tfsdk:"members"`
+		Rules           queryBuilder `tfsdk:"rules"`
+		Type            types.String `tfsdk:"type"`
 	}
 )
 
@@ -98,12 +99,29 @@ func (r *deviceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:    true,
 				ElementType: types.Int32Type,
 			},
+			"device_hostnames": schema.SetAttribute{
+				Description: "The set of device hostnames in the group, resolved to device IDs at apply time. This is only applicable for static device groups," +
+					" and can be used instead of `devices` to reference devices not managed by Terraform.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+
+			// members are recomputed on every refresh since dynamic group membership changes outside of Terraform
+			"members": schema.SetAttribute{
+				Computed:    true,
+				Description: "The set of device IDs currently in the group, as evaluated by LibreNMS. For dynamic groups, these are the devices matched by `rules`.",
+				ElementType: types.Int32Type,
+			},
+			"member_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of devices currently in the group.",
+			},
 
 			"rules": schema.StringAttribute{
 				Description: "The rules for dynamic device groups, in serialized JSON format. This is only applicable for dynamic device groups." +
 					" Using an encoded string supports the arbitrarily-deep nested structure of the LibreNMS rulesets.",
 				Optional:   true,
-				CustomType: jsontypes.NormalizedType{},
+				CustomType: queryBuilderType{},
 			},
 		},
 	}
@@ -116,6 +134,14 @@ func (r *deviceGroupResource) ConfigValidators(ctx context.Context) []resource.C
 			path.MatchRoot("devices"),
 			path.MatchRoot("rules"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("devices"),
+			path.MatchRoot("device_hostnames"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("device_hostnames"),
+			path.MatchRoot("rules"),
+		),
 	}
 }
 
@@ -142,12 +168,12 @@ func (r *deviceGroupResource) ValidateConfig(ctx context.Context, req resource.V
 
 	// If the device group type is static, ensure that devices are provided.
 	if data.Type.ValueString() == "static" {
-		if data.Devices.IsNull() {
+		if data.Devices.IsNull() && data.DeviceHostnames.IsNull() {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("type"),
 				"Missing Static Device Group Devices",
 				"The device group type is set to 'static', but no devices are provided. "+
-					"Please define the `devices` attribute with a list of device IDs.",
+					"Please define the `devices` attribute with a list of device IDs, or the `device_hostnames` attribute with a list of hostnames.",
 			)
 			return
 		}
@@ -196,8 +222,7 @@ func (r *deviceGroupResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	if payload.Type == "static" {
-		payload.Devices = make([]int, 0)
-		diags = plan.Devices.ElementsAs(ctx, &payload.Devices, false)
+		payload.Devices, diags = r.planDeviceIDs(ctx, &plan)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -221,6 +246,12 @@ func (r *deviceGroupResource) Create(ctx context.Context, req resource.CreateReq
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.Int32Value(int32(deviceGroupResp.ID))
 
+	// Read back the group members and the server-side normalized rules
+	resp.Diagnostics.Append(r.readDeviceGroup(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -240,86 +271,11 @@ func (r *deviceGroupResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get refreshed value from LibreNMS API
-	groupResp, err := r.client.GetDeviceGroup(strconv.Itoa(int(state.ID.ValueInt32())))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Device Groups",
-			fmt.Sprintf("Could not read LibreNMS devicegroup ID %d: %s", state.ID.ValueInt32(), err.Error()),
-		)
-		return
-	}
-
-	if groupResp == nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Device Groups",
-			"Received nil response when reading device group. Please check the LibreNMS API.",
-		)
-		return
-	}
-
-	if len(groupResp.Groups) != 1 {
-		resp.Diagnostics.AddError(
-			"Unexpected Device Group Get Response",
-			fmt.Sprintf("Expected one device group to be retrieved, got %d device groups. Please check the LibreNMS API.", len(groupResp.Groups)),
-		)
+	resp.Diagnostics.Append(r.readDeviceGroup(ctx, &state, false)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Overwrite items with refreshed state
-	group := groupResp.Groups[0]
-	state.ID = types.Int32Value(int32(group.ID))
-	state.Name = types.StringValue(group.Name)
-	state.Type = types.StringValue(group.Type)
-
-	// possibly null values
-	state.Description = types.StringNull()
-	if group.Description != nil {
-		state.Description = types.StringValue(*group.Description)
-	}
-
-	if group.Type == "static" {
-		// pull group members list from LibreNMS API
-		members, err := r.client.GetDeviceGroupMembers(strconv.Itoa(group.ID))
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Device Group Members",
-				fmt.Sprintf("Could not read members for device group ID %d: %s", state.ID.ValueInt32(), err.Error()),
-			)
-			return
-		}
-
-		if members == nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Device Group Members",
-				"Received nil response when reading members for device group. Please check the LibreNMS API.",
-			)
-			return
-		}
-
-		// update devices list
-		var devices []int
-		for _, device := range members.Devices {
-			devices = append(devices, device.ID)
-		}
-
-		var devicesDiags diag.Diagnostics
-		state.Devices, devicesDiags = types.SetValueFrom(ctx, types.Int32Type, devices)
-		resp.Diagnostics.Append(devicesDiags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		rules, err := group.Rules.JSON()
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Serializing Device Group Rules",
-				fmt.Sprintf("Could not serialize rules for device group ID %d: %s", state.ID.ValueInt32(), err.Error()),
-			)
-			return
-		}
-		state.Rules = jsontypes.NewNormalizedValue(rules)
-	}
-
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -362,8 +318,7 @@ func (r *deviceGroupResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if payload.Type == "static" {
-		payload.Devices = make([]int, 0)
-		diags = plan.Devices.ElementsAs(ctx, &payload.Devices, false)
+		payload.Devices, diags = r.planDeviceIDs(ctx, &plan)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -383,6 +338,13 @@ func (r *deviceGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Read back the group members and the server-side normalized rules
+	plan.ID = state.ID
+	resp.Diagnostics.Append(r.readDeviceGroup(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -422,3 +384,168 @@ func (r *deviceGroupResource) ImportState(ctx context.Context, req resource.Impo
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
 }
+
+// planDeviceIDs returns the static group device IDs from the plan, resolving `device_hostnames` if set.
+func (r *deviceGroupResource) planDeviceIDs(ctx context.Context, plan *deviceGroupModel) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !plan.DeviceHostnames.IsNull() {
+		var hostnames []string
+		diags.Append(plan.DeviceHostnames.ElementsAs(ctx, &hostnames, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		devices, err := resolveDeviceIDs(r.client, hostnames)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("device_hostnames"),
+				"Error Resolving Device Hostnames",
+				"Could not resolve device hostnames to IDs: "+err.Error(),
+			)
+			return nil, diags
+		}
+		return devices, diags
+	}
+
+	devices := make([]int, 0)
+	diags.Append(plan.Devices.ElementsAs(ctx, &devices, false)...)
+	return devices, diags
+}
+
+// getDeviceGroupMembers retrieves the IDs of the devices currently in the device group.
+func getDeviceGroupMembers(client *librenms.Client, groupID int) ([]int, error) {
+	membersResp, err := client.GetDeviceGroupMembers(strconv.Itoa(groupID))
+	// LibreNMS responds with 404 "No devices found" for a group without members
+	if isNotFoundError(err) {
+		return []int{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if membersResp == nil {
+		return nil, fmt.Errorf("received nil response when reading members, please check the LibreNMS API")
+	}
+
+	members := make([]int, 0, len(membersResp.Devices))
+	for _, device := range membersResp.Devices {
+		members = append(members, device.ID)
+	}
+	return members, nil
+}
+
+// setGroupMembers sets the computed membership attributes on the device group model.
+func setGroupMembers(ctx context.Context, model *deviceGroupModel, members []int) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.Members, diags = types.SetValueFrom(ctx, types.Int32Type, members)
+	model.MemberCount = types.Int32Value(int32(len(members)))
+	return diags
+}
+
+// readDeviceGroup refreshes the device group model, including its members, from the LibreNMS API.
+// During apply, keepPlanned keeps the planned values of the configured attributes, so that only the members and the
+// semantically-equal rules are refreshed; differences in the other attributes are reported by the next Read.
+func (r *deviceGroupResource) readDeviceGroup(ctx context.Context, model *deviceGroupModel, keepPlanned bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupResp, err := r.client.GetDeviceGroup(strconv.Itoa(int(model.ID.ValueInt32())))
+	if err != nil {
+		diags.AddError(
+			"Error Reading Device Groups",
+			fmt.Sprintf("Could not read LibreNMS devicegroup ID %d: %s", model.ID.ValueInt32(), err.Error()),
+		)
+		return diags
+	}
+
+	if groupResp == nil {
+		diags.AddError(
+			"Error Reading Device Groups",
+			"Received nil response when reading device group. Please check the LibreNMS API.",
+		)
+		return diags
+	}
+
+	if len(groupResp.Groups) != 1 {
+		diags.AddError(
+			"Unexpected Device Group Get Response",
+			fmt.Sprintf("Expected one device group to be retrieved, got %d device groups. Please check the LibreNMS API.", len(groupResp.Groups)),
+		)
+		return diags
+	}
+
+	// Overwrite items with refreshed state
+	group := groupResp.Groups[0]
+	model.ID = types.Int32Value(int32(group.ID))
+	if !keepPlanned {
+		model.Name = types.StringValue(group.Name)
+		model.Type = types.StringValue(group.Type)
+
+		// possibly null values
+		model.Description = types.StringNull()
+		if group.Description != nil {
+			model.Description = types.StringValue(*group.Description)
+		}
+	}
+
+	// pull group members list from LibreNMS API
+	members, err := getDeviceGroupMembers(r.client, group.ID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Device Group Members",
+			fmt.Sprintf("Could not read members for device group ID %d: %s", model.ID.ValueInt32(), err.Error()),
+		)
+		return diags
+	}
+
+	diags.Append(setGroupMembers(ctx, model, members)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if group.Type == "static" {
+		if keepPlanned {
+			return diags
+		}
+
+		// static group devices are the group members, referenced by hostname if that's how they're configured
+		if !model.DeviceHostnames.IsNull() {
+			hostnames, err := resolveDeviceHostnames(r.client, members)
+			if err != nil {
+				diags.AddError(
+					"Error Resolving Device Group Members",
+					fmt.Sprintf("Could not resolve member hostnames for device group ID %d: %s", model.ID.ValueInt32(), err.Error()),
+				)
+				return diags
+			}
+
+			var hostnamesDiags diag.Diagnostics
+			model.DeviceHostnames, hostnamesDiags = types.SetValueFrom(ctx, types.StringType, hostnames)
+			diags.Append(hostnamesDiags...)
+		} else {
+			var devicesDiags diag.Diagnostics
+			model.Devices, devicesDiags = types.SetValueFrom(ctx, types.Int32Type, members)
+			diags.Append(devicesDiags...)
+		}
+	} else {
+		rules, err := group.Rules.JSON()
+		if err != nil {
+			diags.AddError(
+				"Error Serializing Device Group Rules",
+				fmt.Sprintf("Could not serialize rules for device group ID %d: %s", model.ID.ValueInt32(), err.Error()),
+			)
+			return diags
+		}
+		refreshed := newQueryBuilderValue(rules)
+		if keepPlanned {
+			equal, d := model.Rules.StringSemanticEquals(ctx, refreshed)
+			diags.Append(d...)
+			if !equal {
+				return diags
+			}
+		}
+		model.Rules = refreshed
+	}
+
+	return diags
+}
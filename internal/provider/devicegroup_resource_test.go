@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccDeviceGroupResource(t *testing.T) {
@@ -61,14 +62,25 @@ resource "librenms_devicegroup" "test1" {
   })
 }
 `,
+				// Verify apply and refresh converge without a second apply
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// check dynamic group attributes
 					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "name", "test group static"),
 					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "type", "static"),
 					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "devices.#", "2"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "members.#", "2"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "member_count", "2"),
 					// check static group attributes
 					resource.TestCheckResourceAttr("librenms_devicegroup.test1", "name", "test group dynamic"),
 					resource.TestCheckResourceAttr("librenms_devicegroup.test1", "type", "dynamic"),
+					// no test devices match the dynamic rules
+					resource.TestCheckResourceAttr("librenms_devicegroup.test1", "members.#", "0"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test1", "member_count", "0"),
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("librenms_devicegroup.test0", "id"),
 					resource.TestCheckResourceAttrSet("librenms_devicegroup.test1", "id"),
@@ -112,6 +124,15 @@ resource "librenms_devicegroup" "test0" {
   ]
 }
 
+# Reference a device by hostname
+resource "librenms_devicegroup" "test2" {
+  name = "test group hostnames"
+  type = "static"
+  device_hostnames = [
+    librenms_device.test_device2.hostname,
+  ]
+}
+
 resource "librenms_devicegroup" "test1" {
   name = "test group dynamic"
   description = "This is a test group"
@@ -131,9 +152,21 @@ resource "librenms_devicegroup" "test1" {
   })
 }
 `,
+				// Verify apply and refresh converge without a second apply
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify device groups updated
 					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "devices.#", "1"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test0", "member_count", "1"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test2", "device_hostnames.#", "1"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test2", "device_hostnames.0", "192.168.5.6"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.test2", "member_count", "1"),
+					// Verify the device reports its group membership after refresh
+					resource.TestCheckResourceAttr("librenms_device.test_device1", "group_ids.#", "1"),
 					resource.TestCheckResourceAttr("librenms_devicegroup.test1", "description", "This is a test group"),
 				),
 			},
@@ -141,3 +174,43 @@ resource "librenms_devicegroup" "test1" {
 		},
 	})
 }
+
+func TestAccDeviceGroupResource_empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A group without members has an empty member set
+			{
+				Config: providerConfig + `
+resource "librenms_devicegroup" "empty" {
+  name = "test group empty"
+  type = "dynamic"
+  rules = jsonencode({
+    "condition" : "AND",
+    "rules" : [
+      {
+        "id" : "devices.hostname",
+        "field" : "devices.hostname",
+        "operator" : "equal",
+        "value" : "no-such-device.invalid"
+      }
+    ],
+    "joins": [],
+    "valid" : true
+  })
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_devicegroup.empty", "members.#", "0"),
+					resource.TestCheckResourceAttr("librenms_devicegroup.empty", "member_count", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -78,3 +78,67 @@ resource "librenms_location" "test_location" {
 		},
 	})
 }
+
+func TestAccLocationResource_deviceLocationID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.5.6"
+  force_add = true
+
+  location_id = librenms_location.test_location.id
+
+  snmp_v2c = {
+    community = "public"
+  }
+}
+
+resource "librenms_location" "test_location" {
+  name = "test location id"
+
+  fixed_coordinates = true
+  latitude = -45.0862462
+  longitude = 37.4220648
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_device.test_device", "location", "test location id"),
+					resource.TestCheckResourceAttrPair("librenms_device.test_device", "location_id", "librenms_location.test_location", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.5.6"
+  force_add = true
+
+  location_id = librenms_location.test_location.id
+
+  snmp_v2c = {
+    community = "public"
+  }
+}
+
+resource "librenms_location" "test_location" {
+  name = "test location id renamed"
+
+  fixed_coordinates = true
+  latitude = -45.0862462
+  longitude = 37.4220648
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_location.test_location", "name", "test location id renamed"),
+					// Verify the device is still associated with the renamed location
+					resource.TestCheckResourceAttrPair("librenms_device.test_device", "location_id", "librenms_location.test_location", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
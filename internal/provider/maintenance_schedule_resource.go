@@ -0,0 +1,641 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones must resolve on hosts without a time zone database
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+const (
+	// maintenanceScheduleTimeFormat is the format of the schedule start and end, in the schedule time zone.
+	maintenanceScheduleTimeFormat = "2006-01-02 15:04"
+
+	// librenmsScheduleTimeFormat is the format of the schedule start and end in the LibreNMS API, in UTC.
+	librenmsScheduleTimeFormat = "2006-01-02 15:04:05"
+)
+
+// maintenanceScheduleDays maps the recurring day names to ISO-8601 day numbers, as used by LibreNMS.
+var maintenanceScheduleDays = map[string]int{
+	"monday":    1,
+	"tuesday":   2,
+	"wednesday": 3,
+	"thursday":  4,
+	"friday":    5,
+	"saturday":  6,
+	"sunday":    7,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &maintenanceScheduleResource{}
+	_ resource.ResourceWithConfigure   = &maintenanceScheduleResource{}
+	_ resource.ResourceWithImportState = &maintenanceScheduleResource{}
+)
+
+// NewMaintenanceScheduleResource is a helper function to simplify the provider implementation.
+func NewMaintenanceScheduleResource() resource.Resource {
+	return &maintenanceScheduleResource{}
+}
+
+type (
+	// maintenanceScheduleResource is the resource implementation.
+	maintenanceScheduleResource struct {
+		client *librenms.Client
+	}
+
+	// maintenanceScheduleModel maps resource schema data to a Go type.
+	maintenanceScheduleModel struct {
+		ID             types.Int32  `tfsdk:"id"`
+		DeviceGroupIDs types.Set    `tfsdk:"device_group_ids"`
+		DeviceIDs      types.Set    `tfsdk:"device_ids"`
+		End            types.String `tfsdk:"end"`
+		LocationIDs    types.Set    `tfsdk:"location_ids"`
+		Notes          types.String `tfsdk:"notes"`
+		RecurringDays  types.Set    `tfsdk:"recurring_days"`
+		Start          types.String `tfsdk:"start"`
+		Timezone       types.String `tfsdk:"timezone"`
+		Title          types.String `tfsdk:"title"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *maintenanceScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *maintenanceScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the LibreNMS maintenance schedule.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_group_ids": schema.SetAttribute{
+				Description: "The set of device group IDs in maintenance during the schedule.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"device_ids": schema.SetAttribute{
+				Description: "The set of device IDs in maintenance during the schedule.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"end": schema.StringAttribute{
+				Description: "The end of the schedule in the format `YYYY-MM-DD hh:mm`, in the schedule `timezone`." +
+					" For recurring schedules, this is the last day of the recurrence and the daily end time.",
+				Required: true,
+			},
+			"location_ids": schema.SetAttribute{
+				Description: "The set of location IDs in maintenance during the schedule.",
+				Optional:    true,
+				ElementType: types.Int32Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Description: "The maintenance schedule notes.",
+				Optional:    true,
+			},
+			"recurring_days": schema.SetAttribute{
+				Description: "The days of the week the schedule recurs on [`monday`, `tuesday`, `wednesday`, `thursday`, `friday`, `saturday`, `sunday`]." +
+					" If not set, the schedule runs once from `start` to `end`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"),
+					),
+				},
+			},
+			"start": schema.StringAttribute{
+				Description: "The start of the schedule in the format `YYYY-MM-DD hh:mm`, in the schedule `timezone`." +
+					" For recurring schedules, this is the first day of the recurrence and the daily start time.",
+				Required: true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The IANA time zone of `start` and `end`, such as `Europe/Amsterdam`. Defaults to `UTC`." +
+					" Recurring schedules are stored in UTC by LibreNMS with the offset of the start date, so their daily window must not" +
+					" cross midnight UTC, and in time zones with daylight saving time it shifts by an hour for part of the year.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("UTC"),
+			},
+			"title": schema.StringAttribute{
+				Description: "The maintenance schedule title.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators defines validation rules for the resource configuration.
+func (r *maintenanceScheduleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("device_ids"),
+			path.MatchRoot("device_group_ids"),
+			path.MatchRoot("location_ids"),
+		),
+	}
+}
+
+// ValidateConfig validates the time zone and the time range of the schedule.
+func (r *maintenanceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data maintenanceScheduleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Timezone.IsUnknown() || data.Start.IsUnknown() || data.End.IsUnknown() || data.RecurringDays.IsUnknown() {
+		return
+	}
+
+	loc := time.UTC
+	if !data.Timezone.IsNull() {
+		var err error
+		loc, err = time.LoadLocation(data.Timezone.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timezone"),
+				"Invalid Time Zone",
+				fmt.Sprintf("The timezone must be an IANA time zone name such as `Europe/Amsterdam`: %s", err),
+			)
+			return
+		}
+	}
+
+	start, err := time.ParseInLocation(maintenanceScheduleTimeFormat, data.Start.ValueString(), loc)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start"),
+			"Invalid Start Value",
+			fmt.Sprintf("The start must be in the format `YYYY-MM-DD hh:mm`: %s", err),
+		)
+	}
+
+	end, err := time.ParseInLocation(maintenanceScheduleTimeFormat, data.End.ValueString(), loc)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid End Value",
+			fmt.Sprintf("The end must be in the format `YYYY-MM-DD hh:mm`: %s", err),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid Schedule Time Range",
+			fmt.Sprintf("The end (%s) must be after the start (%s).", data.End.ValueString(), data.Start.ValueString()),
+		)
+		return
+	}
+
+	if !data.RecurringDays.IsNull() {
+		resp.Diagnostics.Append(validateRecurringWindow(loc, start, end)...)
+	}
+}
+
+// validateRecurringWindow checks the daily window of a recurring schedule once converted to UTC, which is how the
+// payload sends it. LibreNMS keeps a single daily window for the whole recurrence, so in a time zone that observes
+// daylight saving time the window shifts by an hour locally for part of the year, and a window that crosses
+// midnight UTC would never be active.
+func validateRecurringWindow(loc *time.Location, start, end time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, januaryOffset := time.Date(start.Year(), time.January, 1, 0, 0, 0, 0, loc).Zone()
+	_, julyOffset := time.Date(start.Year(), time.July, 1, 0, 0, 0, 0, loc).Zone()
+	_, startOffset := start.Zone()
+	_, endOffset := end.Zone()
+	if januaryOffset != julyOffset || startOffset != endOffset {
+		diags.AddAttributeWarning(
+			path.Root("timezone"),
+			"Recurring Schedule Shifts With Daylight Saving Time",
+			fmt.Sprintf("LibreNMS stores one daily window in UTC for the whole recurrence, converted with the offset of %s on the start date. "+
+				"While %s is on the other side of a daylight saving time change, the window is an hour earlier or later in local time.", loc, loc),
+		)
+	}
+
+	utcStart := start.UTC().Format("15:04")
+	utcEnd := end.UTC().Format("15:04")
+	if utcEnd <= utcStart {
+		diags.AddAttributeError(
+			path.Root("end"),
+			"Invalid Schedule Time Range",
+			fmt.Sprintf("The daily window of a recurring schedule must not cross midnight UTC, but %s-%s in %s is %s-%s in UTC.",
+				start.Format("15:04"), end.Format("15:04"), loc, utcStart, utcEnd),
+		)
+	}
+
+	return diags
+}
+
+// Configure sets the provider client for the resource.
+func (r *maintenanceScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *maintenanceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := maintenanceSchedulePayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleResp, err := r.client.CreateAlertSchedule(payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Maintenance Schedule",
+			fmt.Sprintf("Could not create maintenance schedule: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int32Value(int32(scheduleResp.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *maintenanceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state maintenanceScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	scheduleResp, err := r.client.GetAlertSchedule(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Maintenance Schedule",
+			fmt.Sprintf("Could not read LibreNMS maintenance schedule ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if scheduleResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Maintenance Schedule",
+			"Received nil response when reading maintenance schedule. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	if len(scheduleResp.Schedules) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Maintenance Schedule Get Response",
+			fmt.Sprintf("Expected one maintenance schedule to be retrieved, got %d maintenance schedules. Please check the LibreNMS API.", len(scheduleResp.Schedules)),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(setMaintenanceScheduleState(ctx, &state, &scheduleResp.Schedules[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *maintenanceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := maintenanceSchedulePayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateAlertSchedule(int(plan.ID.ValueInt32()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Maintenance Schedule",
+			fmt.Sprintf("Could not update maintenance schedule: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *maintenanceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state maintenanceScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing schedule
+	_, err := r.client.DeleteAlertSchedule(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Maintenance Schedule",
+			"Could not delete maintenance schedule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a maintenance schedule by numeric ID or by title, optionally followed by a comma and its time zone.
+// ImportState imports a maintenance schedule by numeric ID or by title. Imported schedules use the UTC time zone.
+func (r *maintenanceScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	identifier, timezone := splitMaintenanceScheduleImportID(req.ID)
+
+	id, err := strconv.ParseInt(identifier, 10, 32)
+	if err != nil {
+		schedulesResp, err := r.client.GetAlertSchedules()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Maintenance Schedules",
+				fmt.Sprintf("Could not read maintenance schedules to import %q: %s", identifier, err.Error()),
+			)
+			return
+		}
+
+		if schedulesResp == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Maintenance Schedules",
+				"Received nil response when reading maintenance schedules. Please check the LibreNMS API.",
+			)
+			return
+		}
+
+		id = -1
+		for _, schedule := range schedulesResp.Schedules {
+			if schedule.Title != identifier {
+				continue
+			}
+
+			if id >= 0 {
+				resp.Diagnostics.AddError(
+					"Ambiguous Maintenance Schedule Title",
+					fmt.Sprintf("More than one maintenance schedule is titled %q. Import the schedule by its numeric ID instead.", identifier),
+				)
+				return
+			}
+			id = int64(schedule.ID)
+		}
+
+		if id < 0 {
+			resp.Diagnostics.AddError(
+				"Maintenance Schedule Not Found",
+				fmt.Sprintf("Expected a numeric ID or the title of an existing maintenance schedule for import, but got %q.", identifier),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timezone"), types.StringValue(timezone))...)
+}
+
+// splitMaintenanceScheduleImportID splits an import ID of the form `<id or title>[,<timezone>]`.
+// The suffix is only treated as a time zone if it is a valid IANA time zone name; the time zone defaults to UTC.
+func splitMaintenanceScheduleImportID(importID string) (string, string) {
+	if i := strings.LastIndex(importID, ","); i >= 0 {
+		timezone := strings.TrimSpace(importID[i+1:])
+		if _, err := time.LoadLocation(timezone); timezone != "" && err == nil {
+			return strings.TrimSpace(importID[:i]), timezone
+		}
+	}
+	return importID, "UTC"
+}
+
+// maintenanceSchedulePayload builds the create and update payload from the plan, converting the schedule times to UTC.
+func maintenanceSchedulePayload(ctx context.Context, plan *maintenanceScheduleModel) (*librenms.AlertScheduleCreateRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	loc, err := time.LoadLocation(plan.Timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timezone"), "Invalid Time Zone", err.Error())
+		return nil, diags
+	}
+
+	start, err := time.ParseInLocation(maintenanceScheduleTimeFormat, plan.Start.ValueString(), loc)
+	if err != nil {
+		diags.AddAttributeError(path.Root("start"), "Invalid Start Value", err.Error())
+		return nil, diags
+	}
+
+	end, err := time.ParseInLocation(maintenanceScheduleTimeFormat, plan.End.ValueString(), loc)
+	if err != nil {
+		diags.AddAttributeError(path.Root("end"), "Invalid End Value", err.Error())
+		return nil, diags
+	}
+
+	payload := &librenms.AlertScheduleCreateRequest{
+		Title:         plan.Title.ValueString(),
+		Notes:         plan.Notes.ValueStringPointer(),
+		Start:         start.UTC().Format(librenmsScheduleTimeFormat),
+		End:           end.UTC().Format(librenmsScheduleTimeFormat),
+		Recurring:     librenms.Bool(!plan.RecurringDays.IsNull()),
+		RecurringDays: make([]int, 0),
+		Devices:       make([]int, 0),
+		Groups:        make([]int, 0),
+		Locations:     make([]int, 0),
+	}
+
+	var days []string
+	diags.Append(plan.RecurringDays.ElementsAs(ctx, &days, false)...)
+	for _, day := range days {
+		payload.RecurringDays = append(payload.RecurringDays, maintenanceScheduleDays[day])
+	}
+	// the recurring days are evaluated in UTC, which may be a different day than in the schedule time zone
+	payload.RecurringDays = shiftWeekdays(payload.RecurringDays, utcDayOffset(start))
+
+	diags.Append(plan.DeviceIDs.ElementsAs(ctx, &payload.Devices, false)...)
+	diags.Append(plan.DeviceGroupIDs.ElementsAs(ctx, &payload.Groups, false)...)
+	diags.Append(plan.LocationIDs.ElementsAs(ctx, &payload.Locations, false)...)
+
+	return payload, diags
+}
+
+// setMaintenanceScheduleState maps a LibreNMS alert schedule into the model, converting the schedule times from UTC.
+func setMaintenanceScheduleState(ctx context.Context, state *maintenanceScheduleModel, schedule *librenms.AlertSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	loc, err := time.LoadLocation(state.Timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timezone"), "Invalid Time Zone", err.Error())
+		return diags
+	}
+
+	start, err := parseLibreNMSScheduleTime(schedule.Start)
+	if err != nil {
+		diags.AddError("Error Parsing Maintenance Schedule Start", err.Error())
+		return diags
+	}
+
+	end, err := parseLibreNMSScheduleTime(schedule.End)
+	if err != nil {
+		diags.AddError("Error Parsing Maintenance Schedule End", err.Error())
+		return diags
+	}
+
+	state.ID = types.Int32Value(int32(schedule.ID))
+	state.Title = types.StringValue(schedule.Title)
+	state.Start = types.StringValue(start.In(loc).Format(maintenanceScheduleTimeFormat))
+	state.End = types.StringValue(end.In(loc).Format(maintenanceScheduleTimeFormat))
+
+	state.Notes = types.StringNull()
+	if schedule.Notes != nil && *schedule.Notes != "" {
+		state.Notes = types.StringValue(*schedule.Notes)
+	}
+
+	state.RecurringDays = types.SetNull(types.StringType)
+	if schedule.Recurring {
+		names := make([]string, 0, len(schedule.RecurringDays))
+		for _, day := range shiftWeekdays(schedule.RecurringDays, -utcDayOffset(start.In(loc))) {
+			for name, number := range maintenanceScheduleDays {
+				if number == day {
+					names = append(names, name)
+				}
+			}
+		}
+
+		state.RecurringDays, diags = types.SetValueFrom(ctx, types.StringType, names)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	state.DeviceIDs, diags = setValueOrNull(ctx, schedule.Devices)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.DeviceGroupIDs, diags = setValueOrNull(ctx, schedule.Groups)
+	if diags.HasError() {
+		return diags
+	}
+
+	state.LocationIDs, diags = setValueOrNull(ctx, schedule.Locations)
+	return diags
+}
+
+// setValueOrNull returns a set of the IDs, or a null set if there are none.
+func setValueOrNull(ctx context.Context, ids []int) (types.Set, diag.Diagnostics) {
+	if len(ids) == 0 {
+		return types.SetNull(types.Int32Type), nil
+	}
+	return types.SetValueFrom(ctx, types.Int32Type, ids)
+}
+
+// parseLibreNMSScheduleTime parses a schedule time returned by the LibreNMS API, with or without seconds.
+func parseLibreNMSScheduleTime(value string) (time.Time, error) {
+	t, err := time.Parse(librenmsScheduleTimeFormat, value)
+	if err != nil {
+		return time.Parse(maintenanceScheduleTimeFormat, value)
+	}
+	return t, nil
+}
+
+// utcDayOffset returns the number of days the UTC date of t differs from its date in its own location (-1, 0 or 1).
+func utcDayOffset(t time.Time) int {
+	local := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	utc := t.UTC()
+	return int(time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC).Sub(local).Hours() / 24)
+}
+
+// shiftWeekdays shifts ISO-8601 day numbers by offset days, wrapping around the week.
+func shiftWeekdays(days []int, offset int) []int {
+	shifted := make([]int, 0, len(days))
+	for _, day := range days {
+		shifted = append(shifted, ((day-1+offset)%7+7)%7+1)
+	}
+	slices.Sort(shifted)
+	return shifted
+}
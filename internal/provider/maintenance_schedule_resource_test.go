@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+const maintenanceScheduleSetupConfig = `
+resource "librenms_device" "test_device" {
+  hostname  = "192.168.9.1"
+  icmp_only = {}
+  force_add = true
+}
+
+resource "librenms_devicegroup" "test_group" {
+  name    = "test maintenance group"
+  type    = "static"
+  devices = [librenms_device.test_device.id]
+}
+`
+
+func TestAccMaintenanceScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + maintenanceScheduleSetupConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title      = "test firmware upgrade"
+  notes      = "Core switch firmware upgrade"
+  start      = "2030-01-05 22:00"
+  end        = "2030-01-06 02:00"
+  timezone   = "Europe/Amsterdam"
+  device_ids = [librenms_device.test_device.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "title", "test firmware upgrade"),
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "start", "2030-01-05 22:00"),
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "end", "2030-01-06 02:00"),
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "device_ids.#", "1"),
+					resource.TestCheckNoResourceAttr("librenms_maintenance_schedule.test", "recurring_days"),
+					resource.TestCheckResourceAttrSet("librenms_maintenance_schedule.test", "id"),
+				),
+			},
+			// ImportState by title testing, imported schedules use UTC
+			{
+				ResourceName:            "librenms_maintenance_schedule.test",
+				ImportState:             true,
+				ImportStateId:           "test firmware upgrade",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start", "end", "timezone"},
+			},
+			// ImportState with time zone testing
+			{
+				ResourceName:      "librenms_maintenance_schedule.test",
+				ImportState:       true,
+				ImportStateId:     "test firmware upgrade,Europe/Amsterdam",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + maintenanceScheduleSetupConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title            = "test firmware upgrade"
+  start            = "2030-01-01 01:00"
+  end              = "2030-06-30 03:00"
+  recurring_days   = ["saturday", "sunday"]
+  device_group_ids = [librenms_devicegroup.test_group.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "recurring_days.#", "2"),
+					resource.TestCheckResourceAttr("librenms_maintenance_schedule.test", "device_group_ids.#", "1"),
+					resource.TestCheckNoResourceAttr("librenms_maintenance_schedule.test", "device_ids"),
+					resource.TestCheckNoResourceAttr("librenms_maintenance_schedule.test", "notes"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMaintenanceScheduleResource_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title      = "test invalid time zone"
+  start      = "2030-01-05 22:00"
+  end        = "2030-01-06 02:00"
+  timezone   = "Mars/Olympus_Mons"
+  device_ids = [1]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Time Zone`),
+			},
+			{
+				Config: providerConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title      = "test invalid range"
+  start      = "2030-01-06 02:00"
+  end        = "2030-01-05 22:00"
+  device_ids = [1]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Schedule Time Range`),
+			},
+			{
+				Config: providerConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title          = "test invalid recurring range"
+  start          = "2030-01-01 22:00"
+  end            = "2030-06-30 02:00"
+  recurring_days = ["monday"]
+  device_ids     = [1]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Schedule Time Range`),
+			},
+			{
+				Config: providerConfig + `
+resource "librenms_maintenance_schedule" "test" {
+  title          = "test recurring across midnight utc"
+  start          = "2030-01-01 00:30"
+  end            = "2030-06-30 02:00"
+  timezone       = "Etc/GMT-1"
+  recurring_days = ["monday"]
+  device_ids     = [1]
+}
+`,
+				ExpectError: regexp.MustCompile(`must not cross midnight UTC`),
+			},
+		},
+	})
+}
+
+func TestValidateRecurringWindow(t *testing.T) {
+	tests := map[string]struct {
+		timezone        string
+		start           string
+		end             string
+		expectedError   string
+		expectedWarning string
+	}{
+		"utc": {
+			timezone: "UTC",
+			start:    "2030-01-01 01:00",
+			end:      "2030-06-30 03:00",
+		},
+		"fixed offset": {
+			timezone: "Etc/GMT-1",
+			start:    "2030-01-01 02:00",
+			end:      "2030-06-30 04:00",
+		},
+		"no daylight saving time": {
+			timezone: "Asia/Tokyo",
+			start:    "2030-01-01 10:00",
+			end:      "2030-06-30 12:00",
+		},
+		"daylight saving time": {
+			timezone:        "Europe/Amsterdam",
+			start:           "2030-01-01 02:00",
+			end:             "2030-06-30 04:00",
+			expectedWarning: "Recurring Schedule Shifts With Daylight Saving Time",
+		},
+		"across midnight utc": {
+			timezone:      "Etc/GMT-1",
+			start:         "2030-01-01 00:30",
+			end:           "2030-06-30 02:00",
+			expectedError: "Invalid Schedule Time Range",
+		},
+		"ends at midnight utc": {
+			timezone:      "Etc/GMT+2",
+			start:         "2030-01-01 20:00",
+			end:           "2030-06-30 22:00",
+			expectedError: "Invalid Schedule Time Range",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tc.timezone)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			start, _ := time.ParseInLocation(maintenanceScheduleTimeFormat, tc.start, loc)
+			end, _ := time.ParseInLocation(maintenanceScheduleTimeFormat, tc.end, loc)
+
+			diags := validateRecurringWindow(loc, start, end)
+			if tc.expectedWarning == "" && diags.WarningsCount() > 0 {
+				t.Errorf("unexpected warnings: %v", diags.Warnings())
+			}
+			if tc.expectedWarning != "" && (diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tc.expectedWarning) {
+				t.Errorf("expected warning %q, got %v", tc.expectedWarning, diags.Warnings())
+			}
+			if tc.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tc.expectedError {
+				t.Errorf("expected %q, got %v", tc.expectedError, diags)
+			}
+		})
+	}
+}
+
+func TestSplitMaintenanceScheduleImportID(t *testing.T) {
+	tests := map[string]struct {
+		importID           string
+		expectedIdentifier string
+		expectedTimezone   string
+	}{
+		"id":                  {"12", "12", "UTC"},
+		"id and time zone":    {"12,Europe/Amsterdam", "12", "Europe/Amsterdam"},
+		"title":               {"Core switch upgrade", "Core switch upgrade", "UTC"},
+		"title and time zone": {"Core switch upgrade, Asia/Tokyo", "Core switch upgrade", "Asia/Tokyo"},
+		"title with a comma":  {"Patch window, weekly", "Patch window, weekly", "UTC"},
+		"empty time zone":     {"Core switch upgrade,", "Core switch upgrade,", "UTC"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			identifier, timezone := splitMaintenanceScheduleImportID(tc.importID)
+			if identifier != tc.expectedIdentifier || timezone != tc.expectedTimezone {
+				t.Errorf("expected %q and %q, got %q and %q", tc.expectedIdentifier, tc.expectedTimezone, identifier, timezone)
+			}
+		})
+	}
+}
+
+func TestMaintenanceScheduleTimezoneRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		timezone      string
+		start         string
+		end           string
+		days          []string
+		expectedStart string
+		expectedDays  []int
+	}{
+		"utc": {
+			timezone:      "UTC",
+			start:         "2030-01-05 22:00",
+			end:           "2030-01-06 02:00",
+			expectedStart: "2030-01-05 22:00:00",
+		},
+		"east of utc, previous day in utc": {
+			timezone:      "Asia/Tokyo",
+			start:         "2030-01-01 02:00",
+			end:           "2030-06-30 04:00",
+			days:          []string{"monday", "saturday"},
+			expectedStart: "2029-12-31 17:00:00",
+			expectedDays:  []int{5, 7},
+		},
+		"west of utc, next day in utc": {
+			timezone:      "Etc/GMT+8",
+			start:         "2030-01-01 20:00",
+			end:           "2030-06-30 22:00",
+			days:          []string{"sunday"},
+			expectedStart: "2030-01-02 04:00:00",
+			expectedDays:  []int{1},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := maintenanceScheduleModel{
+				Title:          types.StringValue("test"),
+				Notes:          types.StringNull(),
+				Timezone:       types.StringValue(tc.timezone),
+				Start:          types.StringValue(tc.start),
+				End:            types.StringValue(tc.end),
+				RecurringDays:  types.SetNull(types.StringType),
+				DeviceIDs:      types.SetValueMust(types.Int32Type, nil),
+				DeviceGroupIDs: types.SetNull(types.Int32Type),
+				LocationIDs:    types.SetNull(types.Int32Type),
+			}
+			if tc.days != nil {
+				plan.RecurringDays, _ = types.SetValueFrom(ctx, types.StringType, tc.days)
+			}
+
+			payload, diags := maintenanceSchedulePayload(ctx, &plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if payload.Start != tc.expectedStart {
+				t.Errorf("expected UTC start %q, got %q", tc.expectedStart, payload.Start)
+			}
+			if tc.expectedDays != nil && !slices.Equal(payload.RecurringDays, tc.expectedDays) {
+				t.Errorf("expected UTC recurring days %v, got %v", tc.expectedDays, payload.RecurringDays)
+			}
+
+			state := maintenanceScheduleModel{Timezone: plan.Timezone}
+			diags = setMaintenanceScheduleState(ctx, &state, &librenms.AlertSchedule{
+				Title:         payload.Title,
+				Start:         payload.Start,
+				End:           payload.End,
+				Recurring:     payload.Recurring,
+				RecurringDays: payload.RecurringDays,
+				Devices:       []int{1},
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !state.Start.Equal(plan.Start) || !state.End.Equal(plan.End) {
+				t.Errorf("expected start and end %s - %s, got %s - %s", plan.Start, plan.End, state.Start, state.End)
+			}
+			if tc.days != nil && !state.RecurringDays.Equal(plan.RecurringDays) {
+				t.Errorf("expected recurring days %s, got %s", plan.RecurringDays, state.RecurringDays)
+			}
+			if tc.days == nil && !state.RecurringDays.IsNull() {
+				t.Errorf("expected no recurring days, got %s", state.RecurringDays)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = multilineStringType{}
+	_ basetypes.StringValuable                   = multilineString{}
+	_ basetypes.StringValuableWithSemanticEquals = multilineString{}
+)
+
+type (
+	// multilineStringType is an attribute type for multi-line text bodies, such as alert templates.
+	multilineStringType struct {
+		basetypes.StringType
+	}
+
+	// multilineString is a multi-line text body. Semantic equality ignores line endings, trailing whitespace on each line,
+	// and leading or trailing blank lines, which are commonly changed by heredocs and by LibreNMS when it stores the text.
+	multilineString struct {
+		basetypes.StringValue
+	}
+)
+
+// String returns a human readable string of the type name.
+func (t multilineStringType) String() string {
+	return "provider.multilineStringType"
+}
+
+// ValueType returns the Value type.
+func (t multilineStringType) ValueType(_ context.Context) attr.Value {
+	return multilineString{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t multilineStringType) Equal(o attr.Type) bool {
+	other, ok := o.(multilineStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t multilineStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return multilineString{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t multilineStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return multilineString{StringValue: stringValue}, nil
+}
+
+// newMultilineStringValue creates a multilineString with a known value.
+func newMultilineStringValue(value string) multilineString {
+	return multilineString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns a multilineStringType.
+func (v multilineString) Type(_ context.Context) attr.Type {
+	return multilineStringType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v multilineString) Equal(o attr.Value) bool {
+	other, ok := o.(multilineString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values are equal once whitespace is normalized.
+func (v multilineString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(multilineString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeMultilineString(v.ValueString()) == normalizeMultilineString(newValue.ValueString()), diags
+}
+
+// normalizeMultilineString normalizes line endings, and trims trailing whitespace from each line
+// and leading and trailing blank lines from the text.
+func normalizeMultilineString(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
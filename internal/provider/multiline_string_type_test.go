@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMultilineStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		config   string
+		remote   string
+		expected bool
+	}{
+		"identical": {
+			config:   "{{ $alert->title }}\nSeverity: {{ $alert->severity }}",
+			remote:   "{{ $alert->title }}\nSeverity: {{ $alert->severity }}",
+			expected: true,
+		},
+		"line endings": {
+			config:   "{{ $alert->title }}\nSeverity: {{ $alert->severity }}\n",
+			remote:   "{{ $alert->title }}\r\nSeverity: {{ $alert->severity }}\r\n",
+			expected: true,
+		},
+		"trailing whitespace": {
+			config:   "{{ $alert->title }}\nSeverity: {{ $alert->severity }}",
+			remote:   "{{ $alert->title }}  \nSeverity: {{ $alert->severity }}\t",
+			expected: true,
+		},
+		"leading and trailing blank lines": {
+			config:   "{{ $alert->title }}\n",
+			remote:   "\n{{ $alert->title }}\n\n\n",
+			expected: true,
+		},
+		"indentation": {
+			config:   "@if ($alert->faults)\n  Faults:\n@endif",
+			remote:   "@if ($alert->faults)\nFaults:\n@endif",
+			expected: false,
+		},
+		"blank line between lines": {
+			config:   "{{ $alert->title }}\nSeverity: {{ $alert->severity }}",
+			remote:   "{{ $alert->title }}\n\nSeverity: {{ $alert->severity }}",
+			expected: false,
+		},
+		"different text": {
+			config:   "{{ $alert->title }}",
+			remote:   "{{ $alert->name }}",
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := newMultilineStringValue(tc.config).StringSemanticEquals(context.Background(), newMultilineStringValue(tc.remote))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pollerGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &pollerGroupDataSource{}
+)
+
+// NewPollerGroupDataSource is a helper function to simplify the provider implementation.
+func NewPollerGroupDataSource() datasource.DataSource {
+	return &pollerGroupDataSource{}
+}
+
+type (
+	// pollerGroupDataSource is the data source implementation.
+	pollerGroupDataSource struct {
+		client *librenms.Client
+	}
+)
+
+// Metadata returns the data source type name.
+func (d *pollerGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_poller_group"
+}
+
+// Schema defines the schema for the data source.
+func (d *pollerGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a LibreNMS poller group by name.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "The poller group description, if set.",
+				Computed:    true,
+			},
+			"id": schema.Int32Attribute{
+				Description: "The unique numeric identifier of the poller group, as used by `librenms_device.poller_group`.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The poller group name.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the data source.
+func (d *pollerGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pollerGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pollerGroupModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsResp, err := d.client.GetPollerGroups()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Poller Groups",
+			fmt.Sprintf("Could not read LibreNMS poller groups: %s", err.Error()),
+		)
+		return
+	}
+
+	if groupsResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Poller Groups",
+			"Received nil response when reading poller groups. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	group := findPollerGroup(groupsResp.PollerGroups, state.Name.ValueString())
+	if group == nil {
+		resp.Diagnostics.AddError(
+			"Poller Group Not Found",
+			fmt.Sprintf("No LibreNMS poller group named %q exists.", state.Name.ValueString()),
+		)
+		return
+	}
+
+	setPollerGroupState(&state, group)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPollerGroupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_poller_group" "test" {
+  name        = "test poller group lookup"
+  description = "Looked up by name"
+}
+
+data "librenms_poller_group" "test" {
+  name = librenms_poller_group.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.librenms_poller_group.test", "id", "librenms_poller_group.test", "id"),
+					resource.TestCheckResourceAttr("data.librenms_poller_group.test", "description", "Looked up by name"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "librenms_poller_group" "missing" {
+  name = "test poller group that does not exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`Poller Group Not Found`),
+			},
+		},
+	})
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pollerGroupResource{}
+	_ resource.ResourceWithConfigure   = &pollerGroupResource{}
+	_ resource.ResourceWithImportState = &pollerGroupResource{}
+)
+
+// NewPollerGroupResource is a helper function to simplify the provider implementation.
+func NewPollerGroupResource() resource.Resource {
+	return &pollerGroupResource{}
+}
+
+type (
+	// pollerGroupResource is the resource implementation.
+	pollerGroupResource struct {
+		client *librenms.Client
+	}
+
+	// pollerGroupModel maps resource schema data to a Go type.
+	pollerGroupModel struct {
+		Description types.String `tfsdk:"description"`
+		ID          types.Int32  `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *pollerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_poller_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *pollerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "The poller group description.",
+				Optional:    true,
+			},
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the LibreNMS poller group, as used by `librenms_device.poller_group`.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The poller group name.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *pollerGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *pollerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan pollerGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupResp, err := r.client.CreatePollerGroup(pollerGroupPayload(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Poller Group",
+			fmt.Sprintf("Could not create poller group: %s", err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.Int32Value(int32(groupResp.ID))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *pollerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state pollerGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	groupResp, err := r.client.GetPollerGroup(strconv.Itoa(int(state.ID.ValueInt32())))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Poller Group",
+			fmt.Sprintf("Could not read LibreNMS poller group ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if groupResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Poller Group",
+			"Received nil response when reading poller group. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	if len(groupResp.PollerGroups) != 1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Poller Group Get Response",
+			fmt.Sprintf("Expected one poller group to be retrieved, got %d poller groups. Please check the LibreNMS API.", len(groupResp.PollerGroups)),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	setPollerGroupState(&state, &groupResp.PollerGroups[0])
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *pollerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan pollerGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdatePollerGroup(int(plan.ID.ValueInt32()), pollerGroupPayload(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Poller Group",
+			fmt.Sprintf("Could not update poller group: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *pollerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pollerGroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing poller group
+	_, err := r.client.DeletePollerGroup(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting LibreNMS Poller Group",
+			"Could not delete poller group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a poller group by numeric ID or by name.
+func (r *pollerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		groupsResp, err := r.client.GetPollerGroups()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Poller Groups",
+				fmt.Sprintf("Could not read poller groups to import %q: %s", req.ID, err.Error()),
+			)
+			return
+		}
+
+		if groupsResp == nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Poller Groups",
+				"Received nil response when reading poller groups. Please check the LibreNMS API.",
+			)
+			return
+		}
+
+		group := findPollerGroup(groupsResp.PollerGroups, req.ID)
+		if group == nil {
+			resp.Diagnostics.AddError(
+				"Poller Group Not Found",
+				fmt.Sprintf("Expected a numeric ID or the name of an existing poller group for import, but got %q.", req.ID),
+			)
+			return
+		}
+		id = int64(group.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+}
+
+// pollerGroupPayload builds the create and update payload from the plan.
+func pollerGroupPayload(plan *pollerGroupModel) *librenms.PollerGroupCreateRequest {
+	return &librenms.PollerGroupCreateRequest{
+		GroupName:   plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+}
+
+// setPollerGroupState maps a LibreNMS poller group into the model.
+func setPollerGroupState(state *pollerGroupModel, group *librenms.PollerGroup) {
+	state.ID = types.Int32Value(int32(group.ID))
+	state.Name = types.StringValue(group.GroupName)
+
+	// LibreNMS stores an unset description as an empty string
+	state.Description = types.StringNull()
+	if group.Description != "" {
+		state.Description = types.StringValue(group.Description)
+	}
+}
+
+// findPollerGroup returns the poller group with the given name, or nil if there is none.
+func findPollerGroup(groups []librenms.PollerGroup, name string) *librenms.PollerGroup {
+	for i := range groups {
+		if groups[i].GroupName == name {
+			return &groups[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestAccPollerGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_poller_group" "test" {
+  name = "test poller group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_poller_group.test", "name", "test poller group"),
+					resource.TestCheckNoResourceAttr("librenms_poller_group.test", "description"),
+					resource.TestCheckResourceAttrSet("librenms_poller_group.test", "id"),
+				),
+			},
+			// ImportState by name testing
+			{
+				ResourceName:      "librenms_poller_group.test",
+				ImportState:       true,
+				ImportStateId:     "test poller group",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "librenms_poller_group" "test" {
+  name        = "test poller group renamed"
+  description = "Pollers in the secondary datacenter"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_poller_group.test", "name", "test poller group renamed"),
+					resource.TestCheckResourceAttr("librenms_poller_group.test", "description", "Pollers in the secondary datacenter"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFindPollerGroup(t *testing.T) {
+	groups := []librenms.PollerGroup{
+		{ID: 1, GroupName: "primary"},
+		{ID: 2, GroupName: "secondary"},
+	}
+
+	if group := findPollerGroup(groups, "secondary"); group == nil || group.ID != 2 {
+		t.Errorf("expected poller group 2, got %v", group)
+	}
+
+	if group := findPollerGroup(groups, "Secondary"); group != nil {
+		t.Errorf("expected no poller group, got %v", group)
+	}
+}
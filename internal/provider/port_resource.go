@@ -0,0 +1,622 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &portResource{}
+	_ resource.ResourceWithConfigure   = &portResource{}
+	_ resource.ResourceWithImportState = &portResource{}
+)
+
+// portDescriptionRepoll is the description that removes a port description override in LibreNMS.
+const portDescriptionRepoll = "repoll"
+
+// NewPortResource is a helper function to simplify the provider implementation.
+func NewPortResource() resource.Resource {
+	return &portResource{}
+}
+
+type (
+	// portResource is the resource implementation.
+	portResource struct {
+		client *librenms.Client
+	}
+
+	// portModel maps resource schema data to a Go type.
+	portModel struct {
+		Description types.String `tfsdk:"description"`
+		DeviceID    types.Int32  `tfsdk:"device_id"`
+		Disabled    types.Bool   `tfsdk:"disabled"`
+		ID          types.Int32  `tfsdk:"id"`
+		IfName      types.String `tfsdk:"if_name"`
+		Ignore      types.Bool   `tfsdk:"ignore"`
+		Notes       types.String `tfsdk:"notes"`
+		PortID      types.Int32  `tfsdk:"port_id"`
+		Speed       types.Int64  `tfsdk:"speed"`
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *portResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port"
+}
+
+// Schema defines the schema for the resource.
+func (r *portResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "Overrides the port description (ifAlias) polled from the device. If not set, any override is removed and the polled description is used.",
+				Optional:    true,
+			},
+			"device_id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The ID of the device the port belongs to. Must be set together with `if_name`, unless `port_id` is set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If true, LibreNMS stops polling the port.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The unique numeric identifier of the LibreNMS port.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"if_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The interface name (ifName) of the port, such as `GigabitEthernet1/0/1`. Must be set together with `device_id`, unless `port_id` is set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"ignore": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If true, LibreNMS keeps polling the port, but does not alert on it.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Description: "The port notes.",
+				Optional:    true,
+			},
+			"port_id": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The ID of the port to manage. Conflicts with `device_id` and `if_name`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"speed": schema.Int64Attribute{
+				Description: "Overrides the port speed polled from the device, in bits per second. If not set, any override is removed and the polled speed is used from the next poll.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates that the port is addressed either by port_id, or by device_id and if_name.
+func (r *portResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data portModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PortID.IsNull() {
+		if !data.DeviceID.IsNull() || !data.IfName.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port_id"),
+				"Conflicting Port Address",
+				"The port_id field cannot be set together with device_id or if_name.",
+			)
+		}
+		return
+	}
+
+	if data.DeviceID.IsNull() || data.IfName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Port Address",
+			"Either port_id, or both device_id and if_name, must be set to identify the port.",
+		)
+	}
+}
+
+// Configure sets the provider client for the resource.
+func (r *portResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*librenms.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *librenms.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adopts the existing port and applies the configured settings.
+func (r *portResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan portModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portID, diags := r.findPort(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the current settings of the adopted port are the baseline, so existing overrides that are not configured are removed
+	port, attributes, diags := r.getPortWithOverrides(portID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current portModel
+	setPortState(&current, port, attributes)
+
+	resp.Diagnostics.Append(r.applyPortSettings(port, &plan, &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, attributes, diags = r.getPortWithOverrides(portID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	setPortState(&plan, port, attributes)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *portResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state portModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed value from LibreNMS API
+	portResp, err := r.client.GetPort(int(state.ID.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Port",
+			fmt.Sprintf("Could not read LibreNMS port ID %d: %s", state.ID.ValueInt32(), err.Error()),
+		)
+		return
+	}
+
+	if portResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Port",
+			"Received nil response when reading port. Please check the LibreNMS API.",
+		)
+		return
+	}
+
+	// the port was removed from LibreNMS, for example with its device
+	if len(portResp.Ports) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	port := &portResp.Ports[0]
+	attributes, diags := r.getPortOverrides(port)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overwrite items with refreshed state
+	setPortState(&state, port, attributes)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *portResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state portModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPortSettings(portFromState(&state), &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, attributes, diags := r.getPortWithOverrides(int(state.ID.ValueInt32()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setPortState(&plan, port, attributes)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete restores the default port settings and removes the Terraform state on success. The port itself is kept.
+func (r *portResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state portModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults := portModel{
+		Disabled: types.BoolValue(false),
+		Ignore:   types.BoolValue(false),
+	}
+
+	resp.Diagnostics.Append(r.applyPortSettings(portFromState(&state), &defaults, &state)...)
+}
+
+// ImportState imports a port by numeric ID or in the format `<hostname>/<ifName>`.
+func (r *portResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 32)
+	if err != nil {
+		// interface names may contain slashes, but hostnames do not
+		hostname, ifName, ok := strings.Cut(req.ID, "/")
+		if !ok || hostname == "" || ifName == "" {
+			resp.Diagnostics.AddError(
+				"Error Parsing ID for Import",
+				fmt.Sprintf("Expected a numeric port ID or an ID in the format <hostname>/<ifName> for import, but got %q.", req.ID),
+			)
+			return
+		}
+
+		port, diags := r.getDevicePort(hostname, ifName)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = int64(port.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int32Value(int32(id)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_id"), types.Int32Value(int32(id)))...)
+}
+
+// findPort returns the ID of the port addressed by the plan, verifying that it exists.
+func (r *portResource) findPort(plan *portModel) (int, diag.Diagnostics) {
+	if !plan.PortID.IsNull() && !plan.PortID.IsUnknown() {
+		port, diags := r.getPort(int(plan.PortID.ValueInt32()))
+		if diags.HasError() {
+			return 0, diags
+		}
+		return port.ID, diags
+	}
+
+	port, diags := r.getDevicePort(strconv.Itoa(int(plan.DeviceID.ValueInt32())), plan.IfName.ValueString())
+	if diags.HasError() {
+		return 0, diags
+	}
+	return port.ID, diags
+}
+
+// getPort returns the port with the given ID.
+func (r *portResource) getPort(portID int) (*librenms.Port, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	portResp, err := r.client.GetPort(portID)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Port",
+			fmt.Sprintf("Could not read LibreNMS port ID %d: %s", portID, err.Error()),
+		)
+		return nil, diags
+	}
+
+	if portResp == nil {
+		diags.AddError(
+			"Error Reading Port",
+			"Received nil response when reading port. Please check the LibreNMS API.",
+		)
+		return nil, diags
+	}
+
+	if len(portResp.Ports) != 1 {
+		diags.AddError(
+			"Port Not Found",
+			fmt.Sprintf("Expected one port to be retrieved for ID %d, got %d ports. LibreNMS ports are discovered and cannot be created.", portID, len(portResp.Ports)),
+		)
+		return nil, diags
+	}
+
+	return &portResp.Ports[0], diags
+}
+
+// getDevicePort returns the port of a device, by hostname or device ID, with the given interface name.
+func (r *portResource) getDevicePort(device string, ifName string) (*librenms.Port, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	portsResp, err := r.client.GetDevicePorts(device)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Device Ports",
+			fmt.Sprintf("Could not read ports of LibreNMS device %q: %s", device, err.Error()),
+		)
+		return nil, diags
+	}
+
+	if portsResp == nil {
+		diags.AddError(
+			"Error Reading Device Ports",
+			"Received nil response when reading device ports. Please check the LibreNMS API.",
+		)
+		return nil, diags
+	}
+
+	port := findPortByName(portsResp.Ports, ifName)
+	if port == nil {
+		diags.AddError(
+			"Port Not Found",
+			fmt.Sprintf("Device %q has no port named %q. LibreNMS ports are discovered and cannot be created.", device, ifName),
+		)
+		return nil, diags
+	}
+
+	return port, diags
+}
+
+// getPortWithOverrides returns the port with the given ID, and the attributes of its device.
+func (r *portResource) getPortWithOverrides(portID int) (*librenms.Port, map[string]string, diag.Diagnostics) {
+	port, diags := r.getPort(portID)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	attributes, d := r.getPortOverrides(port)
+	diags.Append(d...)
+	return port, attributes, diags
+}
+
+// getPortOverrides returns the attributes of the device of the port, which record the description and speed overrides.
+func (r *portResource) getPortOverrides(port *librenms.Port) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deviceIdentifier := strconv.Itoa(port.DeviceID)
+	attrResp, err := r.client.GetDeviceAttributes(deviceIdentifier)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Device Attributes",
+			fmt.Sprintf("Could not read attributes for LibreNMS device %s: %s", deviceIdentifier, err.Error()),
+		)
+		return nil, diags
+	}
+
+	if attrResp == nil {
+		diags.AddError(
+			"Error Reading Device Attributes",
+			"Received nil response when reading device attributes. Please check the LibreNMS API.",
+		)
+		return nil, diags
+	}
+
+	return attrResp.Attributes, diags
+}
+
+// applyPortSettings applies the settings that differ between the plan and the state to the port.
+//
+// The poller overwrites ifAlias and ifSpeed unless they are marked as overridden by the `ifName:<ifName>` and
+// `ifSpeed:<ifName>` device attributes. The description endpoint manages the ifName attribute itself, and the
+// speed attribute is set before the speed, so that a poll in between does not revert it.
+func (r *portResource) applyPortSettings(port *librenms.Port, plan, state *portModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deviceIdentifier := strconv.Itoa(port.DeviceID)
+	speedAttribute := portSpeedOverrideAttribute(port.IfName)
+
+	if !plan.Description.Equal(state.Description) {
+		// LibreNMS removes the override on "repoll", so the polled ifAlias is used again
+		description := portDescriptionRepoll
+		if !plan.Description.IsNull() {
+			description = plan.Description.ValueString()
+		}
+
+		if _, err := r.client.UpdatePortDescription(port.ID, description); err != nil {
+			diags.AddError(
+				"Error Updating Port Description",
+				fmt.Sprintf("Could not update the description of port %d: %s", port.ID, err),
+			)
+			return diags
+		}
+	}
+
+	if !plan.Speed.IsNull() && state.Speed.IsNull() {
+		if _, err := r.client.SetDeviceAttribute(deviceIdentifier, speedAttribute, "1"); err != nil {
+			diags.AddError(
+				"Error Setting Device Attribute",
+				fmt.Sprintf("Could not set attribute %q for LibreNMS device %s: %s", speedAttribute, deviceIdentifier, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	payload := portUpdatePayload(plan, state)
+	if len(payload.Field) > 0 {
+		if _, err := r.client.UpdatePort(port.ID, payload); err != nil {
+			diags.AddError(
+				"Error Updating Port",
+				fmt.Sprintf("Could not update port %d: %s", port.ID, err),
+			)
+			return diags
+		}
+	}
+
+	// the polled ifSpeed is used again from the next poll
+	if plan.Speed.IsNull() && !state.Speed.IsNull() {
+		if _, err := r.client.DeleteDeviceAttribute(deviceIdentifier, speedAttribute); err != nil {
+			diags.AddError(
+				"Error Deleting Device Attribute",
+				fmt.Sprintf("Could not delete attribute %q for LibreNMS device %s: %s", speedAttribute, deviceIdentifier, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// portFromState returns the port identity recorded in state.
+func portFromState(state *portModel) *librenms.Port {
+	return &librenms.Port{
+		ID:       int(state.ID.ValueInt32()),
+		DeviceID: int(state.DeviceID.ValueInt32()),
+		IfName:   state.IfName.ValueString(),
+	}
+}
+
+// portUpdatePayload builds the update payload for the port columns that differ between the plan and the state.
+// Unknown plan values are left unchanged, and null values restore the LibreNMS default. The description is
+// updated separately, and a removed speed override is left to the next poll.
+func portUpdatePayload(plan, state *portModel) *librenms.PortUpdateRequest {
+	payload := &librenms.PortUpdateRequest{}
+
+	if !plan.Disabled.IsUnknown() && !plan.Disabled.Equal(state.Disabled) {
+		payload.Field = append(payload.Field, "disabled")
+		payload.Data = append(payload.Data, librenms.Bool(plan.Disabled.ValueBool()))
+	}
+	if !plan.Ignore.IsUnknown() && !plan.Ignore.Equal(state.Ignore) {
+		payload.Field = append(payload.Field, "ignore")
+		payload.Data = append(payload.Data, librenms.Bool(plan.Ignore.ValueBool()))
+	}
+	if !plan.Notes.Equal(state.Notes) {
+		payload.Field = append(payload.Field, "notes")
+		payload.Data = append(payload.Data, plan.Notes.ValueString())
+	}
+	if !plan.Speed.IsNull() && !plan.Speed.Equal(state.Speed) {
+		payload.Field = append(payload.Field, "ifSpeed")
+		payload.Data = append(payload.Data, plan.Speed.ValueInt64())
+	}
+
+	return payload
+}
+
+// setPortState maps a LibreNMS port and the attributes of its device into the model. The description and speed
+// are only read while they are overridden, as LibreNMS otherwise updates them on every poll.
+func setPortState(state *portModel, port *librenms.Port, attributes map[string]string) {
+	state.ID = types.Int32Value(int32(port.ID))
+	state.PortID = types.Int32Value(int32(port.ID))
+	state.DeviceID = types.Int32Value(int32(port.DeviceID))
+	state.IfName = types.StringValue(port.IfName)
+	state.Disabled = types.BoolValue(bool(port.Disabled))
+	state.Ignore = types.BoolValue(bool(port.Ignore))
+
+	state.Description = types.StringNull()
+	if _, ok := attributes[portDescriptionOverrideAttribute(port.IfName)]; ok {
+		state.Description = types.StringValue(port.IfAlias)
+	}
+
+	state.Speed = types.Int64Null()
+	if _, ok := attributes[portSpeedOverrideAttribute(port.IfName)]; ok {
+		state.Speed = types.Int64Value(port.IfSpeed)
+	}
+
+	// LibreNMS stores unset notes as an empty string
+	state.Notes = types.StringNull()
+	if port.Notes != "" {
+		state.Notes = types.StringValue(port.Notes)
+	}
+}
+
+// findPortByName returns the port with the given interface name, or nil if there is none.
+func findPortByName(ports []librenms.Port, ifName string) *librenms.Port {
+	for i := range ports {
+		if ports[i].IfName == ifName {
+			return &ports[i]
+		}
+	}
+	return nil
+}
+
+// portDescriptionOverrideAttribute returns the device attribute that marks the description of a port as overridden.
+func portDescriptionOverrideAttribute(ifName string) string {
+	return "ifName:" + ifName
+}
+
+// portSpeedOverrideAttribute returns the device attribute that marks the speed of a port as overridden.
+func portSpeedOverrideAttribute(ifName string) string {
+	return "ifSpeed:" + ifName
+}
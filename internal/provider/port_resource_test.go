@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// testAccPort returns the discovered port the port acceptance tests adopt. The test LibreNMS instance has no
+// SNMP devices, so the tests are skipped unless LIBRENMS_TEST_PORT_DEVICE_ID, LIBRENMS_TEST_PORT_HOSTNAME and
+// LIBRENMS_TEST_PORT_IFNAME identify a port of a device that is already discovered.
+func testAccPort(t *testing.T) (string, string, string) {
+	deviceID := os.Getenv("LIBRENMS_TEST_PORT_DEVICE_ID")
+	hostname := os.Getenv("LIBRENMS_TEST_PORT_HOSTNAME")
+	ifName := os.Getenv("LIBRENMS_TEST_PORT_IFNAME")
+	if deviceID == "" || hostname == "" || ifName == "" {
+		t.Skip("LIBRENMS_TEST_PORT_DEVICE_ID, LIBRENMS_TEST_PORT_HOSTNAME and LIBRENMS_TEST_PORT_IFNAME must be set to adopt a discovered port")
+	}
+	return deviceID, hostname, ifName
+}
+
+// testAccCheckPortDefaults verifies that the port settings and overrides were restored when the resource was destroyed.
+func testAccCheckPortDefaults(hostname, ifName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := librenms.New("http://localhost:8000", os.Getenv("LIBRENMS_TOKEN"))
+		if err != nil {
+			return err
+		}
+
+		portsResp, err := client.GetDevicePorts(hostname)
+		if err != nil {
+			return err
+		}
+		port := findPortByName(portsResp.Ports, ifName)
+		if port == nil {
+			return fmt.Errorf("port %s/%s was deleted, expected it to be kept", hostname, ifName)
+		}
+		if port.Disabled || port.Ignore || port.Notes != "" {
+			return fmt.Errorf("expected port %s/%s to be restored, got disabled %t, ignore %t and notes %q", hostname, ifName, port.Disabled, port.Ignore, port.Notes)
+		}
+
+		attrResp, err := client.GetDeviceAttributes(hostname)
+		if err != nil {
+			return err
+		}
+		for _, attribute := range []string{portDescriptionOverrideAttribute(ifName), portSpeedOverrideAttribute(ifName)} {
+			if _, ok := attrResp.Attributes[attribute]; ok {
+				return fmt.Errorf("expected device attribute %q to be removed", attribute)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccPortResource(t *testing.T) {
+	deviceID, hostname, ifName := testAccPort(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPortDefaults(hostname, ifName),
+		Steps: []resource.TestStep{
+			// Adopt and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "librenms_port" "test" {
+  device_id   = %s
+  if_name     = %q
+  description = "terraform test port"
+  notes       = "patch panel 3, port 12"
+  speed       = 10000000000
+  ignore      = true
+}
+`, deviceID, ifName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_port.test", "description", "terraform test port"),
+					resource.TestCheckResourceAttr("librenms_port.test", "notes", "patch panel 3, port 12"),
+					resource.TestCheckResourceAttr("librenms_port.test", "speed", "10000000000"),
+					resource.TestCheckResourceAttr("librenms_port.test", "ignore", "true"),
+					resource.TestCheckResourceAttr("librenms_port.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("librenms_port.test", "if_name", ifName),
+					resource.TestCheckResourceAttrSet("librenms_port.test", "id"),
+					resource.TestCheckResourceAttrPair("librenms_port.test", "id", "librenms_port.test", "port_id"),
+				),
+			},
+			// ImportState by hostname and ifName testing
+			{
+				ResourceName:      "librenms_port.test",
+				ImportState:       true,
+				ImportStateId:     hostname + "/" + ifName,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, removing the speed override and the notes
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "librenms_port" "test" {
+  device_id   = %s
+  if_name     = %q
+  description = "terraform test port, updated"
+  ignore      = false
+}
+`, deviceID, ifName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("librenms_port.test", "description", "terraform test port, updated"),
+					resource.TestCheckResourceAttr("librenms_port.test", "ignore", "false"),
+					resource.TestCheckNoResourceAttr("librenms_port.test", "notes"),
+					resource.TestCheckNoResourceAttr("librenms_port.test", "speed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// The devices created by the tests are ICMP only, so they have no discovered ports to adopt.
+func TestAccPortResource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_device" "test" {
+  hostname  = "192.168.10.1"
+  icmp_only = {}
+  force_add = true
+}
+
+resource "librenms_port" "test" {
+  device_id   = librenms_device.test.id
+  if_name     = "GigabitEthernet1/0/1"
+  description = "server01 eth0"
+}
+`,
+				ExpectError: regexp.MustCompile(`Port Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccPortResource_invalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "librenms_port" "test" {
+  port_id = 1
+  if_name = "eth0"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Conflicting Port Address`),
+			},
+			{
+				Config: providerConfig + `
+resource "librenms_port" "test" {
+  if_name = "eth0"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Missing Port Address`),
+			},
+		},
+	})
+}
+
+func TestPortUpdatePayload(t *testing.T) {
+	speed := int64(1000000000)
+	managed := portModel{
+		Description: types.StringValue("server01 eth0"),
+		Disabled:    types.BoolValue(false),
+		Ignore:      types.BoolValue(true),
+		Notes:       types.StringValue("patch panel 3, port 12"),
+		Speed:       types.Int64Value(speed),
+	}
+
+	tests := map[string]struct {
+		plan   portModel
+		state  portModel
+		fields []string
+	}{
+		"adopt with unconfigured flags": {
+			plan: portModel{
+				Description: types.StringValue("server01 eth0"),
+				Disabled:    types.BoolUnknown(),
+				Ignore:      types.BoolUnknown(),
+			},
+		},
+		"adopt with all settings": {
+			plan:   managed,
+			fields: []string{"disabled", "ignore", "notes", "ifSpeed"},
+		},
+		"no changes": {
+			plan:  managed,
+			state: managed,
+		},
+		"change speed": {
+			plan: portModel{
+				Description: managed.Description,
+				Disabled:    managed.Disabled,
+				Ignore:      managed.Ignore,
+				Notes:       managed.Notes,
+				Speed:       types.Int64Value(10 * speed),
+			},
+			state:  managed,
+			fields: []string{"ifSpeed"},
+		},
+		"restore defaults": {
+			plan: portModel{
+				Disabled: types.BoolValue(false),
+				Ignore:   types.BoolValue(false),
+			},
+			state:  managed,
+			fields: []string{"ignore", "notes"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			payload := portUpdatePayload(&test.plan, &test.state)
+			if !slices.Equal(payload.Field, test.fields) {
+				t.Fatalf("expected fields %v, got %v", test.fields, payload.Field)
+			}
+			if len(payload.Data) != len(payload.Field) {
+				t.Fatalf("expected %d data values, got %d", len(payload.Field), len(payload.Data))
+			}
+		})
+	}
+
+	// the overridden speed is sent as a number, and removing the notes sends an empty string
+	payload := portUpdatePayload(&managed, &portModel{})
+	if data := payload.Data[len(payload.Data)-1]; data != speed {
+		t.Errorf("expected speed %d, got %v", speed, data)
+	}
+	payload = portUpdatePayload(&portModel{}, &managed)
+	if data := payload.Data[len(payload.Data)-1]; data != "" {
+		t.Errorf("expected empty notes, got %v", data)
+	}
+}
+
+func TestSetPortState(t *testing.T) {
+	port := &librenms.Port{
+		ID:       10,
+		DeviceID: 1,
+		IfName:   "GigabitEthernet1/0/1",
+		IfAlias:  "server01 eth0",
+		IfSpeed:  1000000000,
+		Ignore:   true,
+	}
+
+	tests := map[string]struct {
+		attributes  map[string]string
+		description types.String
+		speed       types.Int64
+	}{
+		"polled values": {
+			attributes:  map[string]string{"ifName:GigabitEthernet1/0/2": "1"},
+			description: types.StringNull(),
+			speed:       types.Int64Null(),
+		},
+		"description override": {
+			attributes:  map[string]string{"ifName:GigabitEthernet1/0/1": "1"},
+			description: types.StringValue("server01 eth0"),
+			speed:       types.Int64Null(),
+		},
+		"speed override": {
+			attributes:  map[string]string{"ifSpeed:GigabitEthernet1/0/1": "1"},
+			description: types.StringNull(),
+			speed:       types.Int64Value(1000000000),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// overrides removed outside of Terraform are detected, even if they are in state
+			state := portModel{
+				Description: types.StringValue("old description"),
+				Speed:       types.Int64Value(10),
+			}
+			setPortState(&state, port, test.attributes)
+
+			if !state.Description.Equal(test.description) {
+				t.Errorf("expected description %s, got %s", test.description, state.Description)
+			}
+			if !state.Speed.Equal(test.speed) {
+				t.Errorf("expected speed %s, got %s", test.speed, state.Speed)
+			}
+			if state.ID.ValueInt32() != 10 || state.PortID.ValueInt32() != 10 || state.DeviceID.ValueInt32() != 1 {
+				t.Errorf("expected port 10 of device 1, got port %s of device %s", state.ID, state.DeviceID)
+			}
+			if !state.Ignore.ValueBool() || !state.Notes.IsNull() {
+				t.Errorf("expected ignored port without notes, got ignore %s and notes %s", state.Ignore, state.Notes)
+			}
+		})
+	}
+}
+
+func TestFindPortByName(t *testing.T) {
+	ports := []librenms.Port{
+		{ID: 10, IfName: "GigabitEthernet1/0/1"},
+		{ID: 11, IfName: "GigabitEthernet1/0/2"},
+	}
+
+	if port := findPortByName(ports, "GigabitEthernet1/0/2"); port == nil || port.ID != 11 {
+		t.Errorf("expected port 11, got %v", port)
+	}
+
+	if port := findPortByName(ports, "Gi1/0/2"); port != nil {
+		t.Errorf("expected no port, got %v", port)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -20,7 +21,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &librenmsProvider{}
+	_ provider.Provider            = &librenmsProvider{}
+	_ provider.ProviderWithActions = &librenmsProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -165,25 +167,50 @@ func (p *librenmsProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Make the LibreNMS client available during DataSource and Resource type Configure methods.
+	// Make the LibreNMS client available during Action, DataSource and Resource type Configure methods.
+	resp.ActionData = client
 	resp.DataSourceData = client
 	resp.ResourceData = client
 
 	tflog.Info(ctx, "Configured LibreNMS client", map[string]any{"success": true})
 }
 
+// Actions defines the actions implemented in the provider.
+func (p *librenmsProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewAlertAckAction,
+		NewAlertUnmuteAction,
+		NewDeviceDiscoverAction,
+		NewDeviceMaintenanceAction,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *librenmsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewDeviceGroupPreviewDataSource,
+		NewAlertRuleCollectionDataSource,
+		NewAlertTemplatesDataSource,
+		NewAlertsDataSource,
+		NewPollerGroupDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *librenmsProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDeviceResource,
+		NewDeviceDependencyResource,
 		NewDeviceGroupResource,
+		NewDeviceGroupMemberResource,
 		NewAlertRuleResource,
+		NewAlertTemplateResource,
+		NewAlertTransportResource,
+		NewAlertTransportGroupResource,
+		NewMaintenanceScheduleResource,
 		NewLocationResource,
+		NewPollerGroupResource,
+		NewPortResource,
 		NewServiceResource,
 	}
 }
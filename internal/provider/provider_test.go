@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -24,4 +25,8 @@ var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"librenms": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// testAccActionsMinVersion is the first Terraform version that supports actions,
+	// used to skip action acceptance tests on older Terraform CLIs.
+	testAccActionsMinVersion = version.Must(version.NewVersion("1.14.0"))
 )
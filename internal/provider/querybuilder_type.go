@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = queryBuilderType{}
+	_ basetypes.StringValuable                   = queryBuilder{}
+	_ basetypes.StringValuableWithSemanticEquals = queryBuilder{}
+	_ xattr.ValidateableAttribute                = queryBuilder{}
+)
+
+// queryBuilderIgnoredKeys are keys that LibreNMS populates or rewrites when it stores a query builder ruleset.
+// They do not change which devices a ruleset matches, so they are dropped before comparison.
+var queryBuilderIgnoredKeys = []string{"input", "joins", "type", "valid"}
+
+type (
+	// queryBuilderType is an attribute type for the serialized JSON rulesets produced by the LibreNMS query builder,
+	// as used by device group rules and alert rule builders.
+	queryBuilderType struct {
+		basetypes.StringType
+	}
+
+	// queryBuilder is a serialized LibreNMS query builder ruleset. Semantic equality ignores whitespace, key order,
+	// server-populated keys, and numeric vs string rule values, so that LibreNMS normalization does not cause drift.
+	queryBuilder struct {
+		basetypes.StringValue
+	}
+)
+
+// String returns a human readable string of the type name.
+func (t queryBuilderType) String() string {
+	return "provider.queryBuilderType"
+}
+
+// ValueType returns the Value type.
+func (t queryBuilderType) ValueType(_ context.Context) attr.Value {
+	return queryBuilder{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t queryBuilderType) Equal(o attr.Type) bool {
+	other, ok := o.(queryBuilderType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t queryBuilderType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return queryBuilder{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t queryBuilderType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return queryBuilder{StringValue: stringValue}, nil
+}
+
+// newQueryBuilderValue creates a queryBuilder with a known value.
+func newQueryBuilderValue(value string) queryBuilder {
+	return queryBuilder{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns a queryBuilderType.
+func (v queryBuilder) Type(_ context.Context) attr.Type {
+	return queryBuilderType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v queryBuilder) Equal(o attr.Value) bool {
+	other, ok := o.(queryBuilder)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both rulesets are equal once canonicalized.
+func (v queryBuilder) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(queryBuilder)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	result, err := queryBuilderEqual(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"Could not compare query builder rulesets: "+err.Error(),
+		)
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute ensures the value is valid JSON.
+func (v queryBuilder) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.\n\nGiven Value: "+v.ValueString(),
+		)
+	}
+}
+
+// queryBuilderEqual compares two serialized rulesets structurally after canonicalizing them.
+func queryBuilderEqual(a, b string) (bool, error) {
+	ca, err := canonicalQueryBuilder(a)
+	if err != nil {
+		return false, err
+	}
+
+	cb, err := canonicalQueryBuilder(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(ca, cb), nil
+}
+
+// canonicalQueryBuilder decodes a serialized ruleset into a canonical tree.
+func canonicalQueryBuilder(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	// keep numbers as written rather than converting to float64
+	dec.UseNumber()
+
+	var tree interface{}
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	return canonicalizeQueryBuilderNode(tree), nil
+}
+
+// canonicalizeQueryBuilderNode drops server-populated keys and normalizes values of a ruleset node.
+//
+// Groups are objects with `condition` and `rules`, and rules are objects with `field`, `operator`, and `value`.
+// The rule `id` defaults to its `field`, the group `not` flag defaults to false, and scalar values are
+// compared by their string representation since LibreNMS does not preserve the JSON type of rule values.
+func canonicalizeQueryBuilderNode(node interface{}) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			out[k] = canonicalizeQueryBuilderNode(v)
+		}
+
+		for _, k := range queryBuilderIgnoredKeys {
+			delete(out, k)
+		}
+
+		if id, ok := out["id"]; ok && id == out["field"] {
+			delete(out, "id")
+		}
+
+		if not, ok := out["not"]; ok && not == "false" {
+			delete(out, "not")
+		}
+
+		if condition, ok := out["condition"].(string); ok {
+			out["condition"] = strings.ToUpper(condition)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(n))
+		for i, v := range n {
+			out[i] = canonicalizeQueryBuilderNode(v)
+		}
+		return out
+	case json.Number:
+		return n.String()
+	case bool:
+		return fmt.Sprintf("%t", n)
+	default:
+		return n
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQueryBuilderStringSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		config   string
+		remote   string
+		expected bool
+	}{
+		"whitespace and key order": {
+			config:   `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			remote:   `{"rules":[{"value":"ios","operator":"equal","field":"devices.os"}],"condition":"AND"}`,
+			expected: true,
+		},
+		"server-populated keys": {
+			config: `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			remote: `{"condition":"AND","rules":[{"id":"devices.os","field":"devices.os","type":"string","input":"text","operator":"equal","value":"ios"}],` +
+				`"not":false,"joins":[],"valid":true}`,
+			expected: true,
+		},
+		"numeric and string values": {
+			config:   `{"condition": "AND", "rules": [{"field": "devices.port", "operator": "equal", "value": 161}]}`,
+			remote:   `{"condition": "AND", "rules": [{"field": "devices.port", "operator": "equal", "value": "161"}]}`,
+			expected: true,
+		},
+		"nested groups": {
+			config: `{"condition": "OR", "rules": [{"condition": "and", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}]}`,
+			remote: `{"condition":"OR","rules":[{"condition":"AND","rules":[{"id":"devices.os","field":"devices.os","operator":"equal","value":"ios"}],` +
+				`"valid":true}],"valid":true}`,
+			expected: true,
+		},
+		"different value": {
+			config:   `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			remote:   `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "junos"}]}`,
+			expected: false,
+		},
+		"different rule order": {
+			config: `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"},` +
+				`{"field": "devices.type", "operator": "equal", "value": "network"}]}`,
+			remote: `{"condition": "AND", "rules": [{"field": "devices.type", "operator": "equal", "value": "network"},` +
+				`{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			expected: false,
+		},
+		"negated group": {
+			config:   `{"condition": "AND", "not": true, "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			remote:   `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			expected: false,
+		},
+		"custom rule id": {
+			config:   `{"condition": "AND", "rules": [{"id": "custom", "field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			remote:   `{"condition": "AND", "rules": [{"field": "devices.os", "operator": "equal", "value": "ios"}]}`,
+			expected: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := newQueryBuilderValue(tc.config).StringSemanticEquals(context.Background(), newQueryBuilderValue(tc.remote))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, equal)
+			}
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, diags := newQueryBuilderValue(`{`).StringSemanticEquals(context.Background(), newQueryBuilderValue(`{}`))
+		if !diags.HasError() {
+			t.Fatal("expected error diagnostics, got none")
+		}
+	})
+}
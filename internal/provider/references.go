@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+// resolveDeviceIDs resolves device hostnames to their numeric LibreNMS device IDs.
+func resolveDeviceIDs(client *librenms.Client, hostnames []string) ([]int, error) {
+	ids := make([]int, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		device, err := getSingleDevice(client, hostname)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, device.DeviceID)
+	}
+	return ids, nil
+}
+
+// resolveDeviceHostnames resolves numeric LibreNMS device IDs to their hostnames.
+func resolveDeviceHostnames(client *librenms.Client, ids []int) ([]string, error) {
+	hostnames := make([]string, 0, len(ids))
+	for _, id := range ids {
+		device, err := getSingleDevice(client, strconv.Itoa(id))
+		if err != nil {
+			return nil, err
+		}
+		hostnames = append(hostnames, device.Hostname)
+	}
+	return hostnames, nil
+}
+
+// getSingleDevice retrieves a device by hostname or ID, returning an error unless exactly one device is found.
+func getSingleDevice(client *librenms.Client, identifier string) (*librenms.Device, error) {
+	deviceResp, err := client.GetDevice(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("could not read device %q: %w", identifier, err)
+	}
+
+	if deviceResp == nil || len(deviceResp.Devices) != 1 {
+		return nil, fmt.Errorf("device %q was not found", identifier)
+	}
+
+	return &deviceResp.Devices[0], nil
+}
+
+// resolveDeviceGroupIDs resolves device group names to their numeric LibreNMS device group IDs.
+func resolveDeviceGroupIDs(client *librenms.Client, names []string) ([]int, error) {
+	groups, err := getDeviceGroups(client)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]int, len(groups))
+	for _, group := range groups {
+		byName[group.Name] = group.ID
+	}
+
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("device group %q was not found", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveDeviceGroupNames resolves numeric LibreNMS device group IDs to their names.
+func resolveDeviceGroupNames(client *librenms.Client, ids []int) ([]string, error) {
+	groups, err := getDeviceGroups(client)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]string, len(groups))
+	for _, group := range groups {
+		byID[group.ID] = group.Name
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("device group ID %d was not found", id)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// getDeviceGroups retrieves all device groups.
+func getDeviceGroups(client *librenms.Client) ([]librenms.DeviceGroup, error) {
+	groupsResp, err := client.GetDeviceGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not read device groups: %w", err)
+	}
+
+	if groupsResp == nil {
+		return nil, fmt.Errorf("received nil response when reading device groups, please check the LibreNMS API")
+	}
+
+	return groupsResp.Groups, nil
+}
+
+// resolveLocationIDs resolves location names to their numeric LibreNMS location IDs.
+func resolveLocationIDs(client *librenms.Client, names []string) ([]int, error) {
+	locations, err := getLocations(client)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]int, len(locations))
+	for _, location := range locations {
+		byName[location.Name] = location.ID
+	}
+
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("location %q was not found", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveLocationNames resolves numeric LibreNMS location IDs to their names.
+func resolveLocationNames(client *librenms.Client, ids []int) ([]string, error) {
+	locations, err := getLocations(client)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]string, len(locations))
+	for _, location := range locations {
+		byID[location.ID] = location.Name
+	}
+
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		name, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("location ID %d was not found", id)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// getLocations retrieves all locations.
+func getLocations(client *librenms.Client) ([]librenms.Location, error) {
+	locationsResp, err := client.GetLocations()
+	if err != nil {
+		return nil, fmt.Errorf("could not read locations: %w", err)
+	}
+
+	if locationsResp == nil {
+		return nil, fmt.Errorf("received nil response when reading locations, please check the LibreNMS API")
+	}
+
+	return locationsResp.Locations, nil
+}
+
+// isNotFoundError returns true if the LibreNMS API responded with 404 Not Found.
+func isNotFoundError(err error) bool {
+	var apiErr *librenms.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jokelyo/go-librenms"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"not found": {
+			err:  &librenms.APIError{StatusCode: 404, Message: "Device does not exist"},
+			want: true,
+		},
+		"wrapped not found": {
+			err:  fmt.Errorf("reading device: %w", &librenms.APIError{StatusCode: 404, Message: "Device does not exist"}),
+			want: true,
+		},
+		"other status": {
+			err: &librenms.APIError{StatusCode: 500, Message: "Device 404 failed"},
+		},
+		"404 in the message": {
+			err: errors.New("could not read device 404.example.com"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isNotFoundError(tc.err); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Command fetchalertrules vendors the LibreNMS alert rule collection (misc/alert_rules.json) at a pinned release tag.
+//
+// It is run by go generate in internal/provider:
+//
+//	go run ../tools/fetchalertrules -tag <tag> -out data/alert_rules.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+)
+
+const sourceURL = "https://raw.githubusercontent.com/librenms/librenms/%s/misc/alert_rules.json"
+
+func main() {
+	tag := flag.String("tag", "", "LibreNMS release tag to fetch the alert rule collection from")
+	out := flag.String("out", "", "path of the file to write the alert rule collection to")
+	flag.Parse()
+
+	if *tag == "" || *out == "" {
+		log.Fatal("both -tag and -out are required")
+	}
+
+	body, err := fetch(fmt.Sprintf(sourceURL, *tag))
+	if err != nil {
+		log.Fatalf("unable to fetch the alert rule collection for LibreNMS %s: %s", *tag, err)
+	}
+
+	// refuse to vendor anything that is not a list of rules
+	var rules []json.RawMessage
+	if err := json.Unmarshal(body, &rules); err != nil {
+		log.Fatalf("unable to parse the alert rule collection for LibreNMS %s: %s", *tag, err)
+	}
+	if len(rules) == 0 {
+		log.Fatalf("the alert rule collection for LibreNMS %s is empty", *tag)
+	}
+
+	if err := os.WriteFile(*out, body, 0o644); err != nil {
+		log.Fatalf("unable to write %s: %s", *out, err)
+	}
+}
+
+// fetch returns the body of a successful GET request to url.
+func fetch(url string) ([]byte, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}